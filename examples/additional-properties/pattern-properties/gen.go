@@ -0,0 +1,274 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Translations Locale-keyed translations.
+type Translations map[string]string
+
+func (t Translations) Validate() error {
+	var errors runtime.ValidationErrors
+	for k, v := range t {
+		if err := (runtime.PropertyNames{Pattern: "^[a-z]{2}(-[A-Z]{2})?$"}).Validate(k); err != nil {
+			errors = errors.Append(k, err)
+		}
+		matched := false
+		if runtime.MatchPattern("^[a-z]{2}(-[A-Z]{2})?$", k) {
+			matched = true
+			if err := typesValidator.Var(v, "omitempty,max=20"); err != nil {
+				errors = errors.Append(k, err)
+			}
+		}
+		if !matched {
+			errors = errors.Add(k, "is not allowed by patternProperties")
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Labels map[string]string
+
+func (l Labels) Validate() error {
+	var errors runtime.ValidationErrors
+	for k := range l {
+		if err := (runtime.PropertyNames{MinLength: runtime.Ptr(1), MaxLength: runtime.Ptr(8)}).Validate(k); err != nil {
+			errors = errors.Append(k, err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Resource struct {
+	Name                 string            `json:"name" validate:"required"`
+	Tags                 *Resource_Tags    `json:"tags,omitempty"`
+	PatternProperties    map[string]string `json:"-"`
+	AdditionalProperties map[string]int    `json:"-"`
+}
+
+func (r Resource) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(r.Name, "required"); err != nil {
+		errors = errors.Append("Name", err)
+	}
+	if r.Tags != nil {
+		if v, ok := any(r.Tags).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Tags", err)
+			}
+		}
+	}
+	for k, v := range r.PatternProperties {
+		if err := typesValidator.Var(v, "omitempty,max=10"); err != nil {
+			errors = errors.Append(k, err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+// Getter for additional properties for Resource. Returns the specified
+// element and whether it was found
+func (r Resource) Get(fieldName string) (value int, found bool) {
+	if r.AdditionalProperties != nil {
+		value, found = r.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Resource
+func (r *Resource) Set(fieldName string, value int) {
+	if r.AdditionalProperties == nil {
+		r.AdditionalProperties = make(map[string]int)
+	}
+	r.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Resource to handle AdditionalProperties
+func (r *Resource) UnmarshalJSON(data []byte) error {
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+
+	if raw, found := object["name"]; found {
+		if err := json.Unmarshal(raw, &r.Name); err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+	if raw, found := object["tags"]; found {
+		if err := json.Unmarshal(raw, &r.Tags); err != nil {
+			return fmt.Errorf("error reading 'tags': %w", err)
+		}
+		delete(object, "tags")
+	}
+	for fieldName, fieldBuf := range object {
+		if !runtime.MatchPattern("^x-", fieldName) {
+			continue
+		}
+		var fieldVal string
+		if err := json.Unmarshal(fieldBuf, &fieldVal); err != nil {
+			return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+		}
+		if r.PatternProperties == nil {
+			r.PatternProperties = make(map[string]string)
+		}
+		r.PatternProperties[fieldName] = fieldVal
+		delete(object, fieldName)
+	}
+	if len(object) != 0 {
+		r.AdditionalProperties = make(map[string]int)
+		for fieldName, fieldBuf := range object {
+			var fieldVal int
+			if err := json.Unmarshal(fieldBuf, &fieldVal); err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			r.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Resource to handle AdditionalProperties
+func (r Resource) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["name"], err = json.Marshal(r.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	if r.Tags != nil {
+		object["tags"], err = json.Marshal(r.Tags)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'tags': %w", err)
+		}
+	}
+	for fieldName, field := range r.PatternProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	for fieldName, field := range r.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+type Resource_Tags map[string]string
+
+func (r Resource_Tags) Validate() error {
+	var errors runtime.ValidationErrors
+	for k := range r {
+		if err := (runtime.PropertyNames{Enum: []string{"env", "team"}}).Validate(k); err != nil {
+			errors = errors.Append(k, err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Metrics struct {
+	ID                 *string            `json:"id,omitempty"`
+	PatternProperties1 map[string]int     `json:"-"`
+	PatternProperties2 map[string]float64 `json:"-"`
+}
+
+// Override default JSON handling for Metrics to handle AdditionalProperties
+func (m *Metrics) UnmarshalJSON(data []byte) error {
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+
+	if raw, found := object["id"]; found {
+		if err := json.Unmarshal(raw, &m.ID); err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+		delete(object, "id")
+	}
+	for fieldName, fieldBuf := range object {
+		if !runtime.MatchPattern("^count_", fieldName) {
+			continue
+		}
+		var fieldVal int
+		if err := json.Unmarshal(fieldBuf, &fieldVal); err != nil {
+			return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+		}
+		if m.PatternProperties1 == nil {
+			m.PatternProperties1 = make(map[string]int)
+		}
+		m.PatternProperties1[fieldName] = fieldVal
+		delete(object, fieldName)
+	}
+	for fieldName, fieldBuf := range object {
+		if !runtime.MatchPattern("^ratio_", fieldName) {
+			continue
+		}
+		var fieldVal float64
+		if err := json.Unmarshal(fieldBuf, &fieldVal); err != nil {
+			return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+		}
+		if m.PatternProperties2 == nil {
+			m.PatternProperties2 = make(map[string]float64)
+		}
+		m.PatternProperties2[fieldName] = fieldVal
+		delete(object, fieldName)
+	}
+	return nil
+}
+
+// Override default JSON handling for Metrics to handle AdditionalProperties
+func (m Metrics) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if m.ID != nil {
+		object["id"], err = json.Marshal(m.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'id': %w", err)
+		}
+	}
+	for fieldName, field := range m.PatternProperties1 {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	for fieldName, field := range m.PatternProperties2 {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,90 @@
+package gen
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+func TestTranslations(t *testing.T) {
+	var tr Translations
+	require.NoError(t, json.Unmarshal([]byte(`{"en":"Hello","de-DE":"Hallo"}`), &tr))
+	assert.NoError(t, tr.Validate())
+
+	t.Run("invalid key", func(t *testing.T) {
+		err := Translations{"english": "Hello"}.Validate()
+		require.Error(t, err)
+
+		var errs runtime.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 2)
+		assert.Equal(t, "english", errs[0].Field)
+		assert.Equal(t, `property name must match pattern "^[a-z]{2}(-[A-Z]{2})?$"`, errs[0].Message)
+		assert.Equal(t, "english", errs[1].Field)
+		assert.Equal(t, "is not allowed by patternProperties", errs[1].Message)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		err := Translations{"en": "This text is way too long"}.Validate()
+		require.Error(t, err)
+
+		var errs runtime.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 1)
+		assert.Equal(t, "en", errs[0].Field)
+	})
+}
+
+func TestLabels(t *testing.T) {
+	assert.NoError(t, Labels{"env": "prod"}.Validate())
+	assert.Error(t, Labels{"": "prod"}.Validate())
+	assert.Error(t, Labels{"much-too-long": "prod"}.Validate())
+}
+
+func TestResource(t *testing.T) {
+	data := `{"name":"db","tags":{"env":"prod"},"x-owner":"team-a","replicas":3}`
+
+	var r Resource
+	require.NoError(t, json.Unmarshal([]byte(data), &r))
+	assert.Equal(t, "db", r.Name)
+	assert.Equal(t, Resource_Tags{"env": "prod"}, *r.Tags)
+	assert.Equal(t, map[string]string{"x-owner": "team-a"}, r.PatternProperties)
+	assert.Equal(t, map[string]int{"replicas": 3}, r.AdditionalProperties)
+	assert.NoError(t, r.Validate())
+
+	out, err := json.Marshal(r)
+	require.NoError(t, err)
+	assert.JSONEq(t, data, string(out))
+
+	t.Run("invalid", func(t *testing.T) {
+		r := Resource{
+			Name:              "db",
+			Tags:              &Resource_Tags{"owner": "me"},
+			PatternProperties: map[string]string{"x-owner": "a-very-long-owner"},
+		}
+		err := r.Validate()
+		require.Error(t, err)
+
+		var errs runtime.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 2)
+		assert.Equal(t, "Tags.owner", errs[0].Field)
+		assert.Equal(t, "x-owner", errs[1].Field)
+	})
+}
+
+func TestMetrics(t *testing.T) {
+	data := `{"id":"m1","count_requests":10,"ratio_errors":0.5,"unknown":true}`
+
+	var m Metrics
+	require.NoError(t, json.Unmarshal([]byte(data), &m))
+	assert.Equal(t, map[string]int{"count_requests": 10}, m.PatternProperties1)
+	assert.Equal(t, map[string]float64{"ratio_errors": 0.5}, m.PatternProperties2)
+
+	out, err := json.Marshal(m)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"m1","count_requests":10,"ratio_errors":0.5}`, string(out))
+}
@@ -0,0 +1,1029 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package codecs
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	CreatePet(ctx context.Context, options *CreatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePetResponse, error)
+
+	GetConfig(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*GetConfigResponse, error)
+
+	SubmitOrder(ctx context.Context, options *SubmitOrderRequestOptions, reqEditors ...runtime.RequestEditorFn) (*SubmitOrderResponse, error)
+
+	GetInvoice(ctx context.Context, options *GetInvoiceRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetInvoiceResponse, error)
+}
+
+func (c *Client) CreatePet(ctx context.Context, options *CreatePetRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePetResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "CreatePet", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/pets",
+		Method:      "POST",
+		Options:     options,
+		ContentType: "application/xml",
+		Operation: &runtime.OperationInfo{
+			ID:     "CreatePet",
+			Method: "POST",
+			Path:   "/pets",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreatePetResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 201 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(CreatePetResponse)
+		if err = runtime.ClientCodecs(c.apiClient).Unmarshal("application/xml", bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "CreatePet", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/pets")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) GetConfig(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*GetConfigResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/config",
+		Method:     "GET",
+		Operation: &runtime.OperationInfo{
+			ID:     "GetConfig",
+			Method: "GET",
+			Path:   "/config",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetConfigResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(GetConfigResponse)
+		if err = runtime.ClientCodecs(c.apiClient).Unmarshal("application/yaml", bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetConfig", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/config")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) SubmitOrder(ctx context.Context, options *SubmitOrderRequestOptions, reqEditors ...runtime.RequestEditorFn) (*SubmitOrderResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "SubmitOrder", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/orders",
+		Method:      "POST",
+		Options:     options,
+		ContentType: "application/xml",
+		Operation: &runtime.OperationInfo{
+			ID:     "SubmitOrder",
+			Method: "POST",
+			Path:   "/orders",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*SubmitOrderResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(SubmitOrderResponse)
+		if err = runtime.ClientCodecs(c.apiClient).Unmarshal("application/xml", bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "SubmitOrder", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/orders")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) GetInvoice(ctx context.Context, options *GetInvoiceRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetInvoiceResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "GetInvoice", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/invoices/{id}",
+		Method:     "GET",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "GetInvoice",
+			Method: "GET",
+			Path:   "/invoices/{id}",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetInvoiceResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(GetInvoiceResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetInvoice", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/invoices/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// NewInProcessClient creates a Client serving requests with NewRouter(svc) in the same process.
+// No socket is opened, which makes it suitable for end-to-end tests of the client and the service.
+func NewInProcessClient(svc ServiceInterface, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithHTTPClient(runtime.NewHandlerDoer(NewRouter(svc)))}, opts...)
+	return NewDefaultClient("http://in-process", opts...)
+}
+
+// CreatePetRequestOptions is the options needed to make a request to CreatePet.
+type CreatePetRequestOptions struct {
+	Body *CreatePetBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreatePetRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreatePetRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreatePetRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *CreatePetRequestOptions) GetBody() any {
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreatePetRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// SubmitOrderRequestOptions is the options needed to make a request to SubmitOrder.
+type SubmitOrderRequestOptions struct {
+	Body *SubmitOrderBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *SubmitOrderRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *SubmitOrderRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *SubmitOrderRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *SubmitOrderRequestOptions) GetBody() any {
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *SubmitOrderRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// GetInvoiceRequestOptions is the options needed to make a request to GetInvoice.
+type GetInvoiceRequestOptions struct {
+	PathParams *GetInvoicePath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetInvoiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetInvoiceRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetInvoiceRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *GetInvoiceRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetInvoiceRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// OapiErrorKind represents the type of error that occurred during request processing.
+type OapiErrorKind int
+
+const (
+	// OapiErrorKindParse indicates a parameter parsing error (invalid path/query/header parameter).
+	OapiErrorKindParse OapiErrorKind = iota
+
+	// OapiErrorKindDecode indicates a request body decoding error (invalid JSON, form data, etc.).
+	OapiErrorKindDecode
+
+	// OapiErrorKindValidation indicates a request validation error (failed schema validation).
+	OapiErrorKindValidation
+
+	// OapiErrorKindService indicates a service/business logic error returned by the service implementation.
+	OapiErrorKindService
+)
+
+// OapiHandlerError represents an error that occurred during request handling (parse, decode, validation).
+// When no typed error response is configured in the OpenAPI spec, this error type is used.
+// Custom error handlers can type-assert to this type to access error details.
+type OapiHandlerError struct {
+	Kind          OapiErrorKind
+	OperationID   string
+	Message       string
+	ParamName     string
+	ParamLocation string
+}
+
+func (e OapiHandlerError) Error() string {
+	return e.Message
+}
+
+// OapiErrorResponse is the default JSON error response structure used by OapiDefaultErrorHandler.
+type OapiErrorResponse struct {
+	Error         string `json:"error"`
+	OperationID   string `json:"operation_id,omitempty"`
+	ParamName     string `json:"param_name,omitempty"`
+	ParamLocation string `json:"param_location,omitempty"`
+}
+
+// OapiErrorHandler handles errors that occur during request processing.
+// Implement this interface to customize error responses, logging, and metrics.
+type OapiErrorHandler interface {
+	// HandleError writes an error response to w with the given status code.
+	// The err is either an OapiHandlerError (for parse/decode/validation errors)
+	// or a typed error matching the OpenAPI spec's error response schema.
+	HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error)
+}
+
+// OapiDefaultErrorHandler provides the default error handling behavior.
+// It writes JSON error responses. For OapiHandlerError, it uses OapiErrorResponse.
+// For typed errors (from OpenAPI spec), it encodes them directly.
+type OapiDefaultErrorHandler struct{}
+
+// HandleError implements OapiErrorHandler with default JSON error responses.
+func (h *OapiDefaultErrorHandler) HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if handlerErr, ok := err.(OapiHandlerError); ok {
+		_ = json.NewEncoder(w).Encode(OapiErrorResponse{
+			Error:         handlerErr.Message,
+			OperationID:   handlerErr.OperationID,
+			ParamName:     handlerErr.ParamName,
+			ParamLocation: handlerErr.ParamLocation,
+		})
+		return
+	}
+
+	// Typed error from OpenAPI spec - encode directly
+	_ = json.NewEncoder(w).Encode(err)
+}
+
+// ServiceInterface defines the service interface for business logic.
+type ServiceInterface interface {
+	CreatePet(ctx context.Context, opts *CreatePetServiceRequestOptions) (*CreatePetResponseData, error)
+
+	GetConfig(ctx context.Context) (*GetConfigResponseData, error)
+
+	SubmitOrder(ctx context.Context, opts *SubmitOrderServiceRequestOptions) (*SubmitOrderResponseData, error)
+
+	GetInvoice(ctx context.Context, opts *GetInvoiceServiceRequestOptions) (*GetInvoiceResponseData, error)
+}
+
+// HTTPAdapter adapts the ServiceInterface to HTTP handlers.
+// This struct is generated and should not be modified.
+type HTTPAdapter struct {
+	svc        ServiceInterface
+	errHandler OapiErrorHandler
+	codecs     *runtime.CodecRegistry
+}
+
+// NewHTTPAdapter creates a new HTTPAdapter wrapping the given service.
+// If errHandler is nil, OapiDefaultErrorHandler is used.
+func NewHTTPAdapter(svc ServiceInterface, errHandler OapiErrorHandler) *HTTPAdapter {
+	if errHandler == nil {
+		errHandler = &OapiDefaultErrorHandler{}
+	}
+	return &HTTPAdapter{svc: svc, errHandler: errHandler}
+}
+
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If codecs is nil, runtime.DefaultCodecs is used.
+func (a *HTTPAdapter) WithCodecs(codecs *runtime.CodecRegistry) *HTTPAdapter {
+	a.codecs = codecs
+	return a
+}
+
+// CreatePet handles POST /pets
+func (a *HTTPAdapter) CreatePet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := &CreatePetServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	defer r.Body.Close()
+	if err := runtime.DecompressRequestBody(r, 32<<20); err != nil {
+		a.errHandler.HandleError(w, r, runtime.DecompressErrorStatus(err, http.StatusBadRequest), OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreatePet",
+			Message:     err.Error(),
+		})
+		return
+	}
+	var body CreatePetBody
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err == nil {
+		err = a.codecs.Unmarshal("application/xml", bodyBytes, &body)
+	}
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreatePet",
+			Message:     err.Error(),
+		})
+		return
+	}
+	opts.Body = &body
+
+	// Call business logic
+	resp, err := a.svc.CreatePet(ctx, opts)
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 201
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	var data []byte
+	if resp != nil && resp.Body != nil {
+		data, err = a.codecs.Marshal("application/xml", resp.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+// GetConfig handles GET /config
+func (a *HTTPAdapter) GetConfig(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Call business logic
+	resp, err := a.svc.GetConfig(ctx)
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	var data []byte
+	if resp != nil && resp.Body != nil {
+		data, err = a.codecs.Marshal("application/yaml", resp.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+// SubmitOrder handles POST /orders
+func (a *HTTPAdapter) SubmitOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := &SubmitOrderServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	defer r.Body.Close()
+	if err := runtime.DecompressRequestBody(r, 32<<20); err != nil {
+		a.errHandler.HandleError(w, r, runtime.DecompressErrorStatus(err, http.StatusBadRequest), OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "SubmitOrder",
+			Message:     err.Error(),
+		})
+		return
+	}
+	var body SubmitOrderBody
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err == nil {
+		err = a.codecs.Unmarshal("application/xml", bodyBytes, &body)
+	}
+	if err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "SubmitOrder",
+			Message:     err.Error(),
+		})
+		return
+	}
+	opts.Body = &body
+
+	// Call business logic
+	resp, err := a.svc.SubmitOrder(ctx, opts)
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	var data []byte
+	if resp != nil && resp.Body != nil {
+		data, err = a.codecs.Marshal("application/xml", resp.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+// GetInvoice handles GET /invoices/{id}
+func (a *HTTPAdapter) GetInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := &GetInvoiceServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetInvoicePath{}
+	pathParamIDStr := r.PathValue("id")
+	pathParams.ID = pathParamIDStr
+	opts.PathParams = pathParams
+
+	// Call business logic
+	resp, err := a.svc.GetInvoice(ctx, opts)
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// RouterOption is a function that configures the router.
+type RouterOption func(*routerConfig)
+
+type routerConfig struct {
+	middlewares []func(http.Handler) http.Handler
+	errHandler  OapiErrorHandler
+	codecs      *runtime.CodecRegistry
+}
+
+// WithMiddleware adds middleware to the router.
+func WithMiddleware(mw func(http.Handler) http.Handler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.middlewares = append(cfg.middlewares, mw)
+	}
+}
+
+// WithErrorHandler sets a custom error handler for the router.
+// If not set, OapiDefaultErrorHandler is used.
+func WithErrorHandler(h OapiErrorHandler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.errHandler = h
+	}
+}
+
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If not set, runtime.DefaultCodecs is used.
+func WithCodecs(codecs *runtime.CodecRegistry) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.codecs = codecs
+	}
+}
+
+// NewRouter creates a new http.ServeMux with the given service implementation.
+func NewRouter(svc ServiceInterface, opts ...RouterOption) *http.ServeMux {
+	cfg := &routerConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	adapter := NewHTTPAdapter(svc, cfg.errHandler).WithCodecs(cfg.codecs)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /pets", applyMiddleware(http.HandlerFunc(adapter.CreatePet), cfg.middlewares...))
+	mux.HandleFunc("GET /config", applyMiddleware(http.HandlerFunc(adapter.GetConfig), cfg.middlewares...))
+	mux.HandleFunc("POST /orders", applyMiddleware(http.HandlerFunc(adapter.SubmitOrder), cfg.middlewares...))
+	mux.HandleFunc("GET /invoices/{id}", applyMiddleware(http.HandlerFunc(adapter.GetInvoice), cfg.middlewares...))
+
+	return mux
+}
+
+// applyMiddleware wraps a handler with the given middleware chain.
+func applyMiddleware(h http.Handler, middlewares ...func(http.Handler) http.Handler) http.HandlerFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h.ServeHTTP
+}
+
+type GetInvoicePath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetInvoicePath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type CreatePetBody = Pet
+
+type SubmitOrderBody = Order
+
+// CreatePetResponseData wraps the success response with optional headers and status override.
+type CreatePetResponseData struct {
+	Body    *CreatePetResponse
+	Headers http.Header
+	Status  int // 0 = use default (201)
+}
+
+// NewCreatePetResponseData creates a new CreatePetResponseData with the given body.
+func NewCreatePetResponseData(body *CreatePetResponse) *CreatePetResponseData {
+	return &CreatePetResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *CreatePetResponseData) WithHeaders(h http.Header) *CreatePetResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *CreatePetResponseData) WithStatus(code int) *CreatePetResponseData {
+	r.Status = code
+	return r
+}
+
+// GetConfigResponseData wraps the success response with optional headers and status override.
+type GetConfigResponseData struct {
+	Body    *GetConfigResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetConfigResponseData creates a new GetConfigResponseData with the given body.
+func NewGetConfigResponseData(body *GetConfigResponse) *GetConfigResponseData {
+	return &GetConfigResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetConfigResponseData) WithHeaders(h http.Header) *GetConfigResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetConfigResponseData) WithStatus(code int) *GetConfigResponseData {
+	r.Status = code
+	return r
+}
+
+// SubmitOrderResponseData wraps the success response with optional headers and status override.
+type SubmitOrderResponseData struct {
+	Body    *SubmitOrderResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewSubmitOrderResponseData creates a new SubmitOrderResponseData with the given body.
+func NewSubmitOrderResponseData(body *SubmitOrderResponse) *SubmitOrderResponseData {
+	return &SubmitOrderResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *SubmitOrderResponseData) WithHeaders(h http.Header) *SubmitOrderResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *SubmitOrderResponseData) WithStatus(code int) *SubmitOrderResponseData {
+	r.Status = code
+	return r
+}
+
+// GetInvoiceResponseData wraps the success response with optional headers and status override.
+type GetInvoiceResponseData struct {
+	Body    *GetInvoiceResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetInvoiceResponseData creates a new GetInvoiceResponseData with the given body.
+func NewGetInvoiceResponseData(body *GetInvoiceResponse) *GetInvoiceResponseData {
+	return &GetInvoiceResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetInvoiceResponseData) WithHeaders(h http.Header) *GetInvoiceResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetInvoiceResponseData) WithStatus(code int) *GetInvoiceResponseData {
+	r.Status = code
+	return r
+}
+
+type CreatePetResponse = Pet
+
+type GetConfigResponse = Config
+
+type SubmitOrderResponse = Receipt
+
+type GetInvoiceResponse = Invoice
+
+// CreatePetServiceRequestOptions holds all parameters for the CreatePet operation.
+type CreatePetServiceRequestOptions struct {
+	Body *CreatePetBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *CreatePetServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// SubmitOrderServiceRequestOptions holds all parameters for the SubmitOrder operation.
+type SubmitOrderServiceRequestOptions struct {
+	Body *SubmitOrderBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *SubmitOrderServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetInvoiceServiceRequestOptions holds all parameters for the GetInvoice operation.
+type GetInvoiceServiceRequestOptions struct {
+	PathParams *GetInvoicePath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetInvoiceServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+type Pet struct {
+	XMLName xml.Name `json:"-" xml:"pet"`
+	ID      *int     `json:"id,omitempty" xml:"id,attr,omitempty"`
+	Name    string   `json:"name" validate:"required" xml:"name"`
+	Tags    []string `json:"tags,omitempty" xml:"tags>tag,omitempty"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+type Config struct {
+	Version  string   `json:"version" validate:"required"`
+	Features []string `json:"features,omitempty"`
+}
+
+func (c Config) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(c))
+}
+
+type Order struct {
+	XMLName xml.Name `json:"-" xml:"http://example.com/orders Order"`
+	ID      string   `json:"id" validate:"required" xml:"id,attr"`
+	Lines   []Line   `json:"lines,omitempty" xml:"Lines>Line,omitempty"`
+}
+
+func (o Order) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(o.ID, "required"); err != nil {
+		errors = errors.Append("ID", err)
+	}
+	for i, item := range o.Lines {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Lines[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Line struct {
+	XMLName xml.Name `json:"-" xml:"Line"`
+	Sku     string   `json:"sku" validate:"required" xml:"sku"`
+}
+
+func (l Line) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(l))
+}
+
+type Receipt struct {
+	XMLName xml.Name `json:"-" xml:"http://example.com/orders Receipt"`
+	Number  string   `json:"number" validate:"required" xml:"number"`
+}
+
+func (r Receipt) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(r))
+}
+
+type Invoice struct {
+	XMLName xml.Name `json:"-" xml:"Invoice"`
+	Total   float32  `json:"total" validate:"required" xml:"total"`
+	Lines   []Line   `json:"lines,omitempty" xml:"Line,omitempty"`
+}
+
+func (i Invoice) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(i.Total, "required"); err != nil {
+		errors = errors.Append("Total", err)
+	}
+	for i, item := range i.Lines {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Lines[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,123 @@
+package codecs
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+func TestCreatePet_XML(t *testing.T) {
+	client, err := NewInProcessClient(NewService())
+	require.NoError(t, err)
+
+	pet, err := client.CreatePet(context.Background(), &CreatePetRequestOptions{
+		Body: &CreatePetBody{Name: "Rex", Tags: []string{"good", "dog"}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, pet.ID)
+	assert.Equal(t, 1, *pet.ID)
+	assert.Equal(t, "Rex", pet.Name)
+	assert.Equal(t, []string{"good", "dog"}, pet.Tags)
+}
+
+func TestCreatePet_wireFormat(t *testing.T) {
+	srv := httptest.NewServer(NewRouter(NewService()))
+	defer srv.Close()
+
+	body := `<pet><name>Rex</name><tags><tag>good</tag></tags></pet>`
+	resp, err := http.Post(srv.URL+"/pets", "application/xml", strings.NewReader(body))
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "application/xml", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `<pet id="1"><name>Rex</name><tags><tag>good</tag></tags></pet>`, string(data))
+}
+
+func TestCreatePet_invalidXML(t *testing.T) {
+	srv := httptest.NewServer(NewRouter(NewService()))
+	defer srv.Close()
+
+	resp, err := http.Post(srv.URL+"/pets", "application/xml", strings.NewReader("<pet>"))
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestGetConfig_YAML(t *testing.T) {
+	client, err := NewInProcessClient(NewService())
+	require.NoError(t, err)
+
+	cfg, err := client.GetConfig(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "1.0", cfg.Version)
+	assert.Equal(t, []string{"xml", "yaml"}, cfg.Features)
+}
+
+// upperCodec is a custom codec replacing the built-in YAML one.
+type upperCodec struct {
+	runtime.YAMLCodec
+}
+
+func (upperCodec) Marshal(v any) ([]byte, error) {
+	data, err := runtime.YAMLCodec{}.Marshal(v)
+	return []byte(strings.ToUpper(string(data))), err
+}
+
+func TestGetConfig_customCodec(t *testing.T) {
+	codecs := runtime.NewCodecRegistry(runtime.XMLCodec{}, upperCodec{})
+	srv := httptest.NewServer(NewRouter(NewService(), WithCodecs(codecs)))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/config")
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "VERSION:")
+}
+
+func TestSubmitOrder_namespaces(t *testing.T) {
+	srv := httptest.NewServer(NewRouter(NewService()))
+	defer srv.Close()
+
+	body := `<Order xmlns="http://example.com/orders" id="7"><Lines><Line><sku>a</sku></Line></Lines></Order>`
+	resp, err := http.Post(srv.URL+"/orders", "application/xml", strings.NewReader(body))
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `<Receipt xmlns="http://example.com/orders"><number>R-7</number></Receipt>`, string(data))
+
+	// An element in another namespace is not an order.
+	resp, err = http.Post(srv.URL+"/orders", "application/xml", strings.NewReader(`<Order xmlns="http://example.com/other" id="7"></Order>`))
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestGetInvoice_xmlTags(t *testing.T) {
+	client, err := NewInProcessClient(NewService())
+	require.NoError(t, err)
+
+	invoice, err := client.GetInvoice(context.Background(), &GetInvoiceRequestOptions{PathParams: &GetInvoicePath{ID: "1"}})
+	require.NoError(t, err)
+
+	// The JSON operation opts into xml tags with x-xml-tags.
+	data, err := xml.Marshal(invoice)
+	require.NoError(t, err)
+	assert.Equal(t, `<Invoice><total>9.5</total><Line><sku>a</sku></Line><Line><sku>b</sku></Line></Invoice>`, string(data))
+}
@@ -0,0 +1,3 @@
+package codecs
+
+//go:generate go run github.com/uptrace/oapi-codegen-dd/v3/cmd/oapi-codegen -config cfg.yaml api.yaml
@@ -0,0 +1,48 @@
+package codecs
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Service implements the ServiceInterface with an in-memory store.
+type Service struct {
+	mu   sync.Mutex
+	pets []Pet
+}
+
+// NewService creates a new Service.
+func NewService() *Service {
+	return &Service{}
+}
+
+// Ensure Service implements ServiceInterface.
+var _ ServiceInterface = (*Service)(nil)
+
+// CreatePet handles POST /pets
+func (s *Service) CreatePet(ctx context.Context, opts *CreatePetServiceRequestOptions) (*CreatePetResponseData, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pet := *opts.Body
+	pet.ID = runtime.Ptr(len(s.pets) + 1)
+	s.pets = append(s.pets, pet)
+	return NewCreatePetResponseData(&pet), nil
+}
+
+// GetConfig handles GET /config
+func (s *Service) GetConfig(ctx context.Context) (*GetConfigResponseData, error) {
+	return NewGetConfigResponseData(&Config{Version: "1.0", Features: []string{"xml", "yaml"}}), nil
+}
+
+// SubmitOrder handles POST /orders
+func (s *Service) SubmitOrder(ctx context.Context, opts *SubmitOrderServiceRequestOptions) (*SubmitOrderResponseData, error) {
+	return NewSubmitOrderResponseData(&Receipt{Number: "R-" + opts.Body.ID}), nil
+}
+
+// GetInvoice handles GET /invoices/{id}
+func (s *Service) GetInvoice(ctx context.Context, opts *GetInvoiceServiceRequestOptions) (*GetInvoiceResponseData, error) {
+	return NewGetInvoiceResponseData(&Invoice{Total: 9.5, Lines: []Line{{Sku: "a"}, {Sku: "b"}}}), nil
+}
@@ -0,0 +1,222 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package errordetails
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
+// Errors mask the properties marked with x-sensitive-data.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
+	opts = append([]runtime.APIClientOption{runtime.WithErrorSensitiveData(sensitiveData)}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	GetUser(ctx context.Context, options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetUserResponse, error)
+}
+
+func (c *Client) GetUser(ctx context.Context, options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetUserResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "GetUser", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/users/{id}",
+		Method:     "GET",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "GetUser",
+			Method: "GET",
+			Path:   "/users/{id}",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetUserResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			target := new(GetUserErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+					runtime.WithResponse(c.apiClient, resp))
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithResponse(c.apiClient, resp))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(GetUserResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetUser", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// CassetteSensitiveData returns the cassette option masking the JSON properties marked with x-sensitive-data.
+func CassetteSensitiveData() runtime.CassetteOption {
+	return runtime.WithCassetteSensitiveFields(sensitiveData)
+}
+
+// sensitiveData maps the JSON properties marked with x-sensitive-data to their masking rules.
+var sensitiveData = map[string]runtime.SensitiveDataConfig{
+	"token": {
+		Type:       runtime.MaskTypeFull,
+		Pattern:    "",
+		Algorithm:  "",
+		KeepPrefix: 0,
+		KeepSuffix: 0,
+	},
+}
+
+// GetUserRequestOptions is the options needed to make a request to GetUser.
+type GetUserRequestOptions struct {
+	PathParams *GetUserPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetUserRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetUserRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetUserRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *GetUserRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetUserRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type GetUserPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetUserPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type GetUserResponse = User
+
+type GetUserErrorResponse = Error
+
+type User struct {
+	ID   string `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func (u User) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+type Error struct {
+	Message string  `json:"message" validate:"required"`
+	Token   *string `json:"token,omitempty" sensitive:""`
+}
+
+func (e Error) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(e))
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
+
+// Masked returns a copy of the struct with sensitive fields masked.
+func (e Error) Masked() Error {
+	masked := e
+	if masked.Token != nil {
+		v := runtime.MaskSensitiveString(*masked.Token, runtime.SensitiveDataConfig{
+			Type:       runtime.MaskTypeFull,
+			Pattern:    "",
+			Algorithm:  "",
+			KeepPrefix: 0,
+			KeepSuffix: 0,
+		})
+		masked.Token = &v
+	}
+	return masked
+}
+
+// LogValue implements slog.LogValuer interface for structured logging.
+func (e Error) LogValue() slog.Value {
+	type plain Error
+	return slog.AnyValue(plain(e.Masked()))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
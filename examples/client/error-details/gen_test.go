@@ -0,0 +1,108 @@
+package errordetails
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...runtime.APIClientOption) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	opts = append(opts, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	client, err := NewDefaultClient(server.URL, opts...)
+	require.NoError(t, err)
+	return client
+}
+
+func TestClientAPIError_Undocumented(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Header().Set("X-Request-Id", "req-42")
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("<html>502 Bad Gateway</html>"))
+	})
+
+	_, err := client.GetUser(context.Background(), &GetUserRequestOptions{PathParams: &GetUserPath{ID: "1"}})
+	var apiErr *runtime.ClientAPIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode())
+	assert.Equal(t, "GetUser", apiErr.OperationID())
+	assert.Equal(t, "/users/{id}", apiErr.Operation().Path)
+	assert.Equal(t, "req-42", apiErr.RequestID())
+	assert.Equal(t, "<html>502 Bad Gateway</html>", string(apiErr.Body()))
+	assert.Equal(t, "text/html", apiErr.Headers().Get("Content-Type"))
+	assert.True(t, apiErr.IsRetryable())
+}
+
+func TestClientAPIError_Documented(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Trace-Id", "trace-1")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"user 1 not found","token":"secret"}`))
+	}, runtime.WithErrorDetails(runtime.ErrorDetails{
+		RequestIDHeaders: []string{"X-Trace-Id"},
+		SensitiveData: map[string]runtime.SensitiveDataConfig{
+			"token": {Type: runtime.MaskTypeFull},
+		},
+	}))
+
+	_, err := client.GetUser(context.Background(), &GetUserRequestOptions{PathParams: &GetUserPath{ID: "1"}})
+	var apiErr *runtime.ClientAPIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+	assert.Equal(t, "trace-1", apiErr.RequestID())
+	assert.JSONEq(t, `{"message":"user 1 not found","token":"********"}`, string(apiErr.Body()))
+	assert.False(t, apiErr.IsRetryable())
+
+	var notFound GetUserErrorResponse
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, "user 1 not found", notFound.Message)
+}
+
+func TestClientAPIError_SensitiveData(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"user 1 not found","token":"secret"}`))
+	})
+
+	_, err := client.GetUser(context.Background(), &GetUserRequestOptions{PathParams: &GetUserPath{ID: "1"}})
+	var apiErr *runtime.ClientAPIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.JSONEq(t, `{"message":"user 1 not found","token":"********"}`, string(apiErr.Body()))
+}
+
+func TestClientAPIError_DecodingSuccess(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-43")
+		_, _ = w.Write([]byte(`{"id":`))
+	})
+
+	_, err := client.GetUser(context.Background(), &GetUserRequestOptions{PathParams: &GetUserPath{ID: "1"}})
+	var apiErr *runtime.ClientAPIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.ErrorContains(t, err, "error decoding response")
+	assert.Equal(t, http.StatusOK, apiErr.StatusCode())
+	assert.Equal(t, "GetUser", apiErr.OperationID())
+	assert.Equal(t, "req-43", apiErr.RequestID())
+	assert.Equal(t, `{"id":`, string(apiErr.Body()))
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
 )
@@ -21,7 +22,9 @@ func NewClient(apiClient runtime.APIClient) *Client {
 }
 
 // NewDefaultClient creates a new instance of the Client client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
 func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
 	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating API client: %w", err)
@@ -38,10 +41,18 @@ type ClientInterface interface {
 
 func (c *Client) GetClient(ctx context.Context, options *GetClientRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetClientResponse, error) {
 	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "GetClient", options); err != nil {
+		return nil, err
+	}
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL: c.apiClient.GetBaseURL() + "/client",
 		Method:     "GET",
 		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "GetClient",
+			Method: "GET",
+			Path:   "/client",
+		},
 	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
@@ -55,19 +66,23 @@ func (c *Client) GetClient(ctx context.Context, options *GetClientRequestOptions
 			target := new(GetClientErrorResponse)
 			err = json.Unmarshal(bodyBytes, target)
 			if err != nil {
-				return nil, fmt.Errorf("error decoding response: %w", err)
+				return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+					runtime.WithResponse(c.apiClient, resp))
 			}
 
 			if errTarget, ok := any(*target).(error); ok {
-				return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithResponse(c.apiClient, resp))
 			}
 			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
-				runtime.WithStatusCode(resp.StatusCode))
+				runtime.WithResponse(c.apiClient, resp))
 		}
 		target := new(GetClientResponse)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetClient", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
 		}
 		return target, nil
 	}
@@ -81,11 +96,19 @@ func (c *Client) GetClient(ctx context.Context, options *GetClientRequestOptions
 
 func (c *Client) UpdateClient(ctx context.Context, options *UpdateClientRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
 	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "UpdateClient", options); err != nil {
+		return nil, err
+	}
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL:  c.apiClient.GetBaseURL() + "/client",
 		Method:      "PUT",
 		Options:     options,
 		ContentType: "application/x-www-form-urlencoded",
+		Operation: &runtime.OperationInfo{
+			ID:     "UpdateClient",
+			Method: "PUT",
+			Path:   "/client",
+		},
 	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
@@ -99,14 +122,15 @@ func (c *Client) UpdateClient(ctx context.Context, options *UpdateClientRequestO
 			target := new(UpdateClientErrorResponseJSON)
 			err = json.Unmarshal(bodyBytes, target)
 			if err != nil {
-				return nil, fmt.Errorf("error decoding response: %w", err)
+				return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+					runtime.WithResponse(c.apiClient, resp))
 			}
 
 			if errTarget, ok := any(*target).(error); ok {
-				return nil, runtime.NewClientAPIError(errTarget, runtime.WithStatusCode(resp.StatusCode))
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithResponse(c.apiClient, resp))
 			}
 			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
-				runtime.WithStatusCode(resp.StatusCode))
+				runtime.WithResponse(c.apiClient, resp))
 		}
 		return nil, nil
 	}
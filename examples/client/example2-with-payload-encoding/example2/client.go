@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
 )
@@ -21,7 +22,9 @@ func NewClient(apiClient runtime.APIClient) *Client {
 }
 
 // NewDefaultClient creates a new instance of the Client client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
 func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
 	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating API client: %w", err)
@@ -36,6 +39,9 @@ type ClientInterface interface {
 
 func (c *Client) CreateOrder(ctx context.Context, options *CreateOrderRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateOrderResponse, error) {
 	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "CreateOrder", options); err != nil {
+		return nil, err
+	}
 	bodyEncoding := make(map[string]runtime.FieldEncoding)
 	bodyEncoding["client_type"] = runtime.FieldEncoding{
 		ContentType: "",
@@ -48,6 +54,11 @@ func (c *Client) CreateOrder(ctx context.Context, options *CreateOrderRequestOpt
 		Options:      options,
 		ContentType:  "application/x-www-form-urlencoded",
 		BodyEncoding: bodyEncoding,
+		Operation: &runtime.OperationInfo{
+			ID:     "CreateOrder",
+			Method: "POST",
+			Path:   "/order",
+		},
 	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
@@ -59,12 +70,15 @@ func (c *Client) CreateOrder(ctx context.Context, options *CreateOrderRequestOpt
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
 			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
-				runtime.WithStatusCode(resp.StatusCode))
+				runtime.WithResponse(c.apiClient, resp))
 		}
 		target := new(CreateOrderResponse)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "CreateOrder", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
 		}
 		return target, nil
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
 )
@@ -21,7 +22,9 @@ func NewClient(apiClient runtime.APIClient) *Client {
 }
 
 // NewDefaultClient creates a new instance of the Client client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
 func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
 	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating API client: %w", err)
@@ -45,6 +48,11 @@ func (c *Client) GetUserSingle(ctx context.Context, reqEditors ...runtime.Reques
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL: c.apiClient.GetBaseURL() + "/users/{userId}/single",
 		Method:     "GET",
+		Operation: &runtime.OperationInfo{
+			ID:     "GetUserSingle",
+			Method: "GET",
+			Path:   "/users/{userId}/single",
+		},
 	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
@@ -56,14 +64,17 @@ func (c *Client) GetUserSingle(ctx context.Context, reqEditors ...runtime.Reques
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
 			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
-				runtime.WithStatusCode(resp.StatusCode))
+				runtime.WithResponse(c.apiClient, resp))
 		}
 		target := new(GetUserSingleResponse)
 
 		bodyBytes, err = runtime.ConvertFormFields(bodyBytes)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetUserSingle", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
 		}
 		return target, nil
 	}
@@ -80,6 +91,11 @@ func (c *Client) GetUserUnion1(ctx context.Context, reqEditors ...runtime.Reques
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL: c.apiClient.GetBaseURL() + "/users/{userId}/union-1",
 		Method:     "GET",
+		Operation: &runtime.OperationInfo{
+			ID:     "GetUserUnion1",
+			Method: "GET",
+			Path:   "/users/{userId}/union-1",
+		},
 	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
@@ -91,14 +107,17 @@ func (c *Client) GetUserUnion1(ctx context.Context, reqEditors ...runtime.Reques
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
 			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
-				runtime.WithStatusCode(resp.StatusCode))
+				runtime.WithResponse(c.apiClient, resp))
 		}
 		target := new(GetUserUnion1Response)
 
 		bodyBytes, err = runtime.ConvertFormFields(bodyBytes)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetUserUnion1", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
 		}
 		return target, nil
 	}
@@ -115,6 +134,11 @@ func (c *Client) GetUserUnion2(ctx context.Context, reqEditors ...runtime.Reques
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL: c.apiClient.GetBaseURL() + "/users/{userId}/union-2",
 		Method:     "GET",
+		Operation: &runtime.OperationInfo{
+			ID:     "GetUserUnion2",
+			Method: "GET",
+			Path:   "/users/{userId}/union-2",
+		},
 	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
@@ -126,14 +150,17 @@ func (c *Client) GetUserUnion2(ctx context.Context, reqEditors ...runtime.Reques
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
 			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
-				runtime.WithStatusCode(resp.StatusCode))
+				runtime.WithResponse(c.apiClient, resp))
 		}
 		target := new(GetUserUnion2Response)
 
 		bodyBytes, err = runtime.ConvertFormFields(bodyBytes)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetUserUnion2", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
 		}
 		return target, nil
 	}
@@ -150,6 +177,11 @@ func (c *Client) GetUserUnion3(ctx context.Context, reqEditors ...runtime.Reques
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL: c.apiClient.GetBaseURL() + "/users/{userId}/union-3",
 		Method:     "GET",
+		Operation: &runtime.OperationInfo{
+			ID:     "GetUserUnion3",
+			Method: "GET",
+			Path:   "/users/{userId}/union-3",
+		},
 	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
@@ -161,14 +193,17 @@ func (c *Client) GetUserUnion3(ctx context.Context, reqEditors ...runtime.Reques
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
 			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
-				runtime.WithStatusCode(resp.StatusCode))
+				runtime.WithResponse(c.apiClient, resp))
 		}
 		target := new(GetUserUnion3Response)
 
 		bodyBytes, err = runtime.ConvertFormFields(bodyBytes)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetUserUnion3", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
 		}
 		return target, nil
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
@@ -22,7 +23,9 @@ func NewClient(apiClient runtime.APIClient) *Client {
 }
 
 // NewDefaultClient creates a new instance of the Client client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
 func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
 	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating API client: %w", err)
@@ -37,6 +40,9 @@ type ClientInterface interface {
 
 func (c *Client) GetCharge(ctx context.Context, options *GetChargeRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetChargeResponse, error) {
 	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "GetCharge", options); err != nil {
+		return nil, err
+	}
 
 	queryEncoding := map[string]runtime.QueryEncoding{
 		"expand": {Style: "form", Explode: &[]bool{false}[0]},
@@ -46,6 +52,11 @@ func (c *Client) GetCharge(ctx context.Context, options *GetChargeRequestOptions
 		Method:        "GET",
 		Options:       options,
 		QueryEncoding: queryEncoding,
+		Operation: &runtime.OperationInfo{
+			ID:     "GetCharge",
+			Method: "GET",
+			Path:   "/charges/{id}",
+		},
 	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
@@ -57,12 +68,15 @@ func (c *Client) GetCharge(ctx context.Context, options *GetChargeRequestOptions
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
 			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
-				runtime.WithStatusCode(resp.StatusCode))
+				runtime.WithResponse(c.apiClient, resp))
 		}
 		target := new(GetChargeResponse)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetCharge", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
 		}
 		return target, nil
 	}
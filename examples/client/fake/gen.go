@@ -0,0 +1,544 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	GetUser(ctx context.Context, options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetUserResponse, error)
+
+	DeleteUser(ctx context.Context, options *DeleteUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error)
+
+	ListUsers(ctx context.Context, options *ListUsersRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListUsersResponse, error)
+	// ListUsersAll iterates over the items of all pages of ListUsers.
+	ListUsersAll(ctx context.Context, options *ListUsersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[User, error]
+}
+
+func (c *Client) GetUser(ctx context.Context, options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetUserResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "GetUser", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/users/{id}",
+		Method:     "GET",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "GetUser",
+			Method: "GET",
+			Path:   "/users/{id}",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetUserResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			target := new(GetUserErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+					runtime.WithResponse(c.apiClient, resp))
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithResponse(c.apiClient, resp))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(GetUserResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetUser", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) DeleteUser(ctx context.Context, options *DeleteUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "DeleteUser", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/users/{id}",
+		Method:     "DELETE",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "DeleteUser",
+			Method: "DELETE",
+			Path:   "/users/{id}",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*struct{}, error) {
+		if resp.StatusCode != 204 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		return nil, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) ListUsers(ctx context.Context, options *ListUsersRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListUsersResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "ListUsers", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/users",
+		Method:     "GET",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "ListUsers",
+			Method: "GET",
+			Path:   "/users",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListUsersResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(ListUsersResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "ListUsers", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// ListUsersAll iterates over the items of all pages of ListUsers, fetching the pages lazily.
+// It stops on the first error, including the cancellation of ctx.
+func (c *Client) ListUsersAll(ctx context.Context, options *ListUsersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[User, error] {
+	return func(yield func(User, error) bool) {
+		var zero User
+		var opts ListUsersRequestOptions
+		if options != nil {
+			opts = *options
+		}
+		var query ListUsersQuery
+		if opts.Query != nil {
+			query = *opts.Query
+		}
+		opts.Query = &query
+		seen := make(map[string]bool)
+		if query.Cursor != nil {
+			seen[*query.Cursor] = true
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			page, err := c.ListUsers(ctx, &opts, reqEditors...)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			items := page.Data
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if page.NextCursor == nil {
+				return
+			}
+			next := *page.NextCursor
+			if next == "" {
+				return
+			}
+			if seen[next] {
+				yield(zero, fmt.Errorf("%w: cursor %v", runtime.ErrPaginationLoop, next))
+				return
+			}
+			seen[next] = true
+			query.Cursor = &next
+		}
+	}
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// FakeClient is an in-memory implementation of ClientInterface for tests.
+// For each operation, <Op>Func is called if set, otherwise <Op>Err or <Op>Response is returned.
+// Operations without a programmed response return runtime.ErrFakeNotConfigured.
+// All calls are recorded with their request options.
+type FakeClient struct {
+	runtime.FakeCalls
+
+	GetUserFunc     func(ctx context.Context, options *GetUserRequestOptions) (*GetUserResponse, error)
+	GetUserResponse *GetUserResponse
+	GetUserErr      error
+
+	DeleteUserFunc     func(ctx context.Context, options *DeleteUserRequestOptions) (*struct{}, error)
+	DeleteUserResponse *struct{}
+	DeleteUserErr      error
+
+	ListUsersFunc     func(ctx context.Context, options *ListUsersRequestOptions) (*ListUsersResponse, error)
+	ListUsersResponse *ListUsersResponse
+	ListUsersErr      error
+	ListUsersAllFunc  func(ctx context.Context, options *ListUsersRequestOptions) iter.Seq2[User, error]
+}
+
+// GetUser records the call and returns the programmed response.
+func (f *FakeClient) GetUser(ctx context.Context, options *GetUserRequestOptions, _ ...runtime.RequestEditorFn) (*GetUserResponse, error) {
+	f.Record("GetUser", options)
+	switch {
+	case f.GetUserFunc != nil:
+		return f.GetUserFunc(ctx, options)
+	case f.GetUserErr != nil:
+		return nil, f.GetUserErr
+	case f.GetUserResponse != nil:
+		return f.GetUserResponse, nil
+	}
+	return nil, fmt.Errorf("%w: GetUser", runtime.ErrFakeNotConfigured)
+}
+
+// GetUserCalls returns the options of the recorded calls to GetUser.
+func (f *FakeClient) GetUserCalls() []*GetUserRequestOptions {
+	var res []*GetUserRequestOptions
+	for _, call := range f.CallsTo("GetUser") {
+		options, _ := call.Options.(*GetUserRequestOptions)
+		res = append(res, options)
+	}
+	return res
+}
+
+// DeleteUser records the call and returns the programmed response.
+func (f *FakeClient) DeleteUser(ctx context.Context, options *DeleteUserRequestOptions, _ ...runtime.RequestEditorFn) (*struct{}, error) {
+	f.Record("DeleteUser", options)
+	switch {
+	case f.DeleteUserFunc != nil:
+		return f.DeleteUserFunc(ctx, options)
+	case f.DeleteUserErr != nil:
+		return nil, f.DeleteUserErr
+	case f.DeleteUserResponse != nil:
+		return f.DeleteUserResponse, nil
+	}
+	return nil, nil
+}
+
+// DeleteUserCalls returns the options of the recorded calls to DeleteUser.
+func (f *FakeClient) DeleteUserCalls() []*DeleteUserRequestOptions {
+	var res []*DeleteUserRequestOptions
+	for _, call := range f.CallsTo("DeleteUser") {
+		options, _ := call.Options.(*DeleteUserRequestOptions)
+		res = append(res, options)
+	}
+	return res
+}
+
+// ListUsers records the call and returns the programmed response.
+func (f *FakeClient) ListUsers(ctx context.Context, options *ListUsersRequestOptions, _ ...runtime.RequestEditorFn) (*ListUsersResponse, error) {
+	f.Record("ListUsers", options)
+	switch {
+	case f.ListUsersFunc != nil:
+		return f.ListUsersFunc(ctx, options)
+	case f.ListUsersErr != nil:
+		return nil, f.ListUsersErr
+	case f.ListUsersResponse != nil:
+		return f.ListUsersResponse, nil
+	}
+	return nil, fmt.Errorf("%w: ListUsers", runtime.ErrFakeNotConfigured)
+}
+
+// ListUsersCalls returns the options of the recorded calls to ListUsers.
+func (f *FakeClient) ListUsersCalls() []*ListUsersRequestOptions {
+	var res []*ListUsersRequestOptions
+	for _, call := range f.CallsTo("ListUsers") {
+		options, _ := call.Options.(*ListUsersRequestOptions)
+		res = append(res, options)
+	}
+	return res
+}
+
+// ListUsersAll records the call and calls ListUsersAllFunc if set.
+// Otherwise it iterates over the items of the single page returned by ListUsers.
+func (f *FakeClient) ListUsersAll(ctx context.Context, options *ListUsersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[User, error] {
+	f.Record("ListUsersAll", options)
+	if f.ListUsersAllFunc != nil {
+		return f.ListUsersAllFunc(ctx, options)
+	}
+	return func(yield func(User, error) bool) {
+		page, err := f.ListUsers(ctx, options, reqEditors...)
+		if err != nil {
+			var zero User
+			yield(zero, err)
+			return
+		}
+		items := page.Data
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+var _ ClientInterface = (*FakeClient)(nil)
+
+// GetUserRequestOptions is the options needed to make a request to GetUser.
+type GetUserRequestOptions struct {
+	PathParams *GetUserPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetUserRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetUserRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetUserRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *GetUserRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetUserRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// DeleteUserRequestOptions is the options needed to make a request to DeleteUser.
+type DeleteUserRequestOptions struct {
+	PathParams *DeleteUserPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *DeleteUserRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *DeleteUserRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *DeleteUserRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *DeleteUserRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *DeleteUserRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// ListUsersRequestOptions is the options needed to make a request to ListUsers.
+type ListUsersRequestOptions struct {
+	Query *ListUsersQuery
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *ListUsersRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *ListUsersRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *ListUsersRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *ListUsersRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *ListUsersRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type GetUserPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetUserPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type DeleteUserPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (d DeleteUserPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(d))
+}
+
+type ListUsersQuery struct {
+	Cursor *string `json:"cursor,omitempty"`
+}
+
+type GetUserResponse = User
+
+type GetUserErrorResponse = Error
+
+type ListUsersResponse struct {
+	Data       []User  `json:"data" validate:"required"`
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+type User struct {
+	ID   string `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func (u User) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+type Error struct {
+	Message string `json:"message" validate:"required"`
+}
+
+func (e Error) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(e))
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
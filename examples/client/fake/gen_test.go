@@ -0,0 +1,95 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+func TestDisplayName(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("canned response", func(t *testing.T) {
+		client := &FakeClient{GetUserResponse: &GetUserResponse{ID: "1", Name: "Ada"}}
+
+		name, err := DisplayName(ctx, client, "1")
+		require.NoError(t, err)
+		assert.Equal(t, "Ada", name)
+
+		client.AssertCalledTimes(t, "GetUser", 1)
+		client.AssertCalledWith(t, "GetUser", &GetUserRequestOptions{PathParams: &GetUserPath{ID: "1"}})
+		assert.Equal(t, "1", client.GetUserCalls()[0].PathParams.ID)
+	})
+
+	t.Run("typed error", func(t *testing.T) {
+		client := &FakeClient{
+			GetUserErr: runtime.NewClientAPIError(Error{Message: "not found"}, runtime.WithStatusCode(http.StatusNotFound)),
+		}
+
+		name, err := DisplayName(ctx, client, "2")
+		require.NoError(t, err)
+		assert.Equal(t, "unknown", name)
+	})
+
+	t.Run("function", func(t *testing.T) {
+		client := &FakeClient{
+			GetUserFunc: func(_ context.Context, options *GetUserRequestOptions) (*GetUserResponse, error) {
+				return &GetUserResponse{ID: options.PathParams.ID, Name: "user " + options.PathParams.ID}, nil
+			},
+		}
+
+		name, err := DisplayName(ctx, client, "3")
+		require.NoError(t, err)
+		assert.Equal(t, "user 3", name)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		client := &FakeClient{}
+
+		_, err := DisplayName(ctx, client, "4")
+		assert.ErrorIs(t, err, runtime.ErrFakeNotConfigured)
+		client.AssertNotCalled(t, "DeleteUser")
+	})
+}
+
+func TestCountUsers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("single page from the canned response", func(t *testing.T) {
+		client := &FakeClient{ListUsersResponse: &ListUsersResponse{Data: []User{{ID: "1"}, {ID: "2"}}}}
+
+		count, err := CountUsers(ctx, client)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		client.AssertCalled(t, "ListUsersAll")
+	})
+
+	t.Run("iterator function", func(t *testing.T) {
+		client := &FakeClient{
+			ListUsersAllFunc: func(context.Context, *ListUsersRequestOptions) iter.Seq2[User, error] {
+				return func(yield func(User, error) bool) {
+					if yield(User{ID: "1"}, nil) {
+						yield(User{}, errors.New("page 2 failed"))
+					}
+				}
+			},
+		}
+
+		_, err := CountUsers(ctx, client)
+		assert.EqualError(t, err, "page 2 failed")
+	})
+}
+
+func TestDeleteUser(t *testing.T) {
+	client := &FakeClient{}
+
+	_, err := client.DeleteUser(context.Background(), &DeleteUserRequestOptions{PathParams: &DeleteUserPath{ID: "1"}})
+	require.NoError(t, err)
+	client.AssertCalled(t, "DeleteUser")
+}
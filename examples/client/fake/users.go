@@ -0,0 +1,34 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// DisplayName returns the name of the user, or "unknown" if the user doesn't exist.
+func DisplayName(ctx context.Context, client ClientInterface, id string) (string, error) {
+	user, err := client.GetUser(ctx, &GetUserRequestOptions{PathParams: &GetUserPath{ID: id}})
+	var apiErr *runtime.ClientAPIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode() == http.StatusNotFound {
+		return "unknown", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return user.Name, nil
+}
+
+// CountUsers returns the number of users across all pages.
+func CountUsers(ctx context.Context, client ClientInterface) (int, error) {
+	count := 0
+	for _, err := range client.ListUsersAll(ctx, nil) {
+		if err != nil {
+			return 0, err
+		}
+		count++
+	}
+	return count, nil
+}
@@ -0,0 +1,466 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	CreatePayment(ctx context.Context, options *CreatePaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePaymentResponse, error)
+}
+
+func (c *Client) CreatePayment(ctx context.Context, options *CreatePaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePaymentResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "CreatePayment", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/payments",
+		Method:      "POST",
+		Options:     options,
+		ContentType: "application/json",
+		Idempotent:  true,
+		Operation: &runtime.OperationInfo{
+			ID:     "CreatePayment",
+			Method: "POST",
+			Path:   "/payments",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreatePaymentResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 201 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(CreatePaymentResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "CreatePayment", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/payments")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// NewInProcessClient creates a Client serving requests with NewRouter(svc) in the same process.
+// No socket is opened, which makes it suitable for end-to-end tests of the client and the service.
+func NewInProcessClient(svc ServiceInterface, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithHTTPClient(runtime.NewHandlerDoer(NewRouter(svc)))}, opts...)
+	return NewDefaultClient("http://in-process", opts...)
+}
+
+// CreatePaymentRequestOptions is the options needed to make a request to CreatePayment.
+type CreatePaymentRequestOptions struct {
+	Body *CreatePaymentBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreatePaymentRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreatePaymentRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreatePaymentRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *CreatePaymentRequestOptions) GetBody() any {
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreatePaymentRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// OapiErrorKind represents the type of error that occurred during request processing.
+type OapiErrorKind int
+
+const (
+	// OapiErrorKindParse indicates a parameter parsing error (invalid path/query/header parameter).
+	OapiErrorKindParse OapiErrorKind = iota
+
+	// OapiErrorKindDecode indicates a request body decoding error (invalid JSON, form data, etc.).
+	OapiErrorKindDecode
+
+	// OapiErrorKindValidation indicates a request validation error (failed schema validation).
+	OapiErrorKindValidation
+
+	// OapiErrorKindService indicates a service/business logic error returned by the service implementation.
+	OapiErrorKindService
+)
+
+// OapiHandlerError represents an error that occurred during request handling (parse, decode, validation).
+// When no typed error response is configured in the OpenAPI spec, this error type is used.
+// Custom error handlers can type-assert to this type to access error details.
+type OapiHandlerError struct {
+	Kind          OapiErrorKind
+	OperationID   string
+	Message       string
+	ParamName     string
+	ParamLocation string
+}
+
+func (e OapiHandlerError) Error() string {
+	return e.Message
+}
+
+// OapiErrorResponse is the default JSON error response structure used by OapiDefaultErrorHandler.
+type OapiErrorResponse struct {
+	Error         string `json:"error"`
+	OperationID   string `json:"operation_id,omitempty"`
+	ParamName     string `json:"param_name,omitempty"`
+	ParamLocation string `json:"param_location,omitempty"`
+}
+
+// OapiErrorHandler handles errors that occur during request processing.
+// Implement this interface to customize error responses, logging, and metrics.
+type OapiErrorHandler interface {
+	// HandleError writes an error response to w with the given status code.
+	// The err is either an OapiHandlerError (for parse/decode/validation errors)
+	// or a typed error matching the OpenAPI spec's error response schema.
+	HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error)
+}
+
+// OapiDefaultErrorHandler provides the default error handling behavior.
+// It writes JSON error responses. For OapiHandlerError, it uses OapiErrorResponse.
+// For typed errors (from OpenAPI spec), it encodes them directly.
+type OapiDefaultErrorHandler struct{}
+
+// HandleError implements OapiErrorHandler with default JSON error responses.
+func (h *OapiDefaultErrorHandler) HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if handlerErr, ok := err.(OapiHandlerError); ok {
+		_ = json.NewEncoder(w).Encode(OapiErrorResponse{
+			Error:         handlerErr.Message,
+			OperationID:   handlerErr.OperationID,
+			ParamName:     handlerErr.ParamName,
+			ParamLocation: handlerErr.ParamLocation,
+		})
+		return
+	}
+
+	// Typed error from OpenAPI spec - encode directly
+	_ = json.NewEncoder(w).Encode(err)
+}
+
+// ServiceInterface defines the service interface for business logic.
+type ServiceInterface interface {
+	CreatePayment(ctx context.Context, opts *CreatePaymentServiceRequestOptions) (*CreatePaymentResponseData, error)
+}
+
+// HTTPAdapter adapts the ServiceInterface to HTTP handlers.
+// This struct is generated and should not be modified.
+type HTTPAdapter struct {
+	svc              ServiceInterface
+	errHandler       OapiErrorHandler
+	idempotencyStore runtime.IdempotencyStore
+	codecs           *runtime.CodecRegistry
+}
+
+// NewHTTPAdapter creates a new HTTPAdapter wrapping the given service.
+// If errHandler is nil, OapiDefaultErrorHandler is used.
+func NewHTTPAdapter(svc ServiceInterface, errHandler OapiErrorHandler) *HTTPAdapter {
+	if errHandler == nil {
+		errHandler = &OapiDefaultErrorHandler{}
+	}
+	return &HTTPAdapter{svc: svc, errHandler: errHandler}
+}
+
+// WithIdempotencyStore sets the store used to replay the responses of x-idempotent operations
+// for duplicate Idempotency-Key headers. If store is nil, requests are not deduplicated.
+func (a *HTTPAdapter) WithIdempotencyStore(store runtime.IdempotencyStore) *HTTPAdapter {
+	a.idempotencyStore = store
+	return a
+}
+
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If codecs is nil, runtime.DefaultCodecs is used.
+func (a *HTTPAdapter) WithCodecs(codecs *runtime.CodecRegistry) *HTTPAdapter {
+	a.codecs = codecs
+	return a
+}
+
+// CreatePayment handles POST /payments
+func (a *HTTPAdapter) CreatePayment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if a.idempotencyStore != nil {
+		rec, replayed, err := runtime.StartIdempotent(w, r, a.idempotencyStore, "CreatePayment", 32<<20)
+		if err != nil {
+			a.errHandler.HandleError(w, r, runtime.IdempotencyErrorStatus(err, http.StatusInternalServerError), err)
+			return
+		}
+		if replayed {
+			return
+		}
+		if rec != nil {
+			// A panicking handler releases the key, instead of storing a partial response.
+			defer func() {
+				if p := recover(); p != nil {
+					_ = rec.Release()
+					panic(p)
+				}
+				_ = rec.Finish()
+			}()
+			w = rec
+		}
+	}
+	opts := &CreatePaymentServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	defer r.Body.Close()
+	if err := runtime.DecompressRequestBody(r, 32<<20); err != nil {
+		a.errHandler.HandleError(w, r, runtime.DecompressErrorStatus(err, http.StatusBadRequest), OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreatePayment",
+			Message:     err.Error(),
+		})
+		return
+	}
+	var body CreatePaymentBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreatePayment",
+			Message:     err.Error(),
+		})
+		return
+	}
+	opts.Body = &body
+
+	// Call business logic
+	resp, err := a.svc.CreatePayment(ctx, opts)
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 201
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// RouterOption is a function that configures the router.
+type RouterOption func(*routerConfig)
+
+type routerConfig struct {
+	middlewares      []func(http.Handler) http.Handler
+	errHandler       OapiErrorHandler
+	idempotencyStore runtime.IdempotencyStore
+	codecs           *runtime.CodecRegistry
+}
+
+// WithMiddleware adds middleware to the router.
+func WithMiddleware(mw func(http.Handler) http.Handler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.middlewares = append(cfg.middlewares, mw)
+	}
+}
+
+// WithErrorHandler sets a custom error handler for the router.
+// If not set, OapiDefaultErrorHandler is used.
+func WithErrorHandler(h OapiErrorHandler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.errHandler = h
+	}
+}
+
+// WithIdempotencyStore sets the store used to replay the responses of x-idempotent operations.
+// If not set, duplicate requests are not detected.
+func WithIdempotencyStore(store runtime.IdempotencyStore) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.idempotencyStore = store
+	}
+}
+
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If not set, runtime.DefaultCodecs is used.
+func WithCodecs(codecs *runtime.CodecRegistry) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.codecs = codecs
+	}
+}
+
+// NewRouter creates a new http.ServeMux with the given service implementation.
+func NewRouter(svc ServiceInterface, opts ...RouterOption) *http.ServeMux {
+	cfg := &routerConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	adapter := NewHTTPAdapter(svc, cfg.errHandler).WithIdempotencyStore(cfg.idempotencyStore).WithCodecs(cfg.codecs)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /payments", applyMiddleware(http.HandlerFunc(adapter.CreatePayment), cfg.middlewares...))
+
+	return mux
+}
+
+// applyMiddleware wraps a handler with the given middleware chain.
+func applyMiddleware(h http.Handler, middlewares ...func(http.Handler) http.Handler) http.HandlerFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h.ServeHTTP
+}
+
+type CreatePaymentBody struct {
+	Amount int `json:"amount" validate:"required"`
+}
+
+func (c CreatePaymentBody) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(c))
+}
+
+// CreatePaymentResponseData wraps the success response with optional headers and status override.
+type CreatePaymentResponseData struct {
+	Body    *CreatePaymentResponse
+	Headers http.Header
+	Status  int // 0 = use default (201)
+}
+
+// NewCreatePaymentResponseData creates a new CreatePaymentResponseData with the given body.
+func NewCreatePaymentResponseData(body *CreatePaymentResponse) *CreatePaymentResponseData {
+	return &CreatePaymentResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *CreatePaymentResponseData) WithHeaders(h http.Header) *CreatePaymentResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *CreatePaymentResponseData) WithStatus(code int) *CreatePaymentResponseData {
+	r.Status = code
+	return r
+}
+
+type CreatePaymentResponse = Payment
+
+// CreatePaymentServiceRequestOptions holds all parameters for the CreatePayment operation.
+type CreatePaymentServiceRequestOptions struct {
+	Body *CreatePaymentBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *CreatePaymentServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+type Payment struct {
+	ID     string `json:"id" validate:"required"`
+	Amount int    `json:"amount" validate:"required"`
+}
+
+func (p Payment) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
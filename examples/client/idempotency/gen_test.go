@@ -0,0 +1,155 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// dropFirstResponse runs the handler but answers the first request with a 503,
+// as if the response was lost on the way back.
+func dropFirstResponse(next http.Handler) http.Handler {
+	dropped := false
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if dropped {
+			next.ServeHTTP(w, r)
+			return
+		}
+		dropped = true
+		next.ServeHTTP(httptest.NewRecorder(), r)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+}
+
+func TestIdempotentRetry(t *testing.T) {
+	svc := NewService()
+	router := NewRouter(svc, WithIdempotencyStore(runtime.NewMemoryIdempotencyStore(time.Hour)))
+
+	var keys []string
+	client, err := NewDefaultClient("http://in-process",
+		runtime.WithHTTPClient(runtime.NewHandlerDoer(dropFirstResponse(router))),
+		runtime.WithRetryPolicy(runtime.RetryPolicy{
+			InitialBackoff: time.Millisecond,
+			OnAttempt: func(_ context.Context, a runtime.RetryAttempt) {
+				keys = append(keys, a.Request.Header.Get(runtime.IdempotencyKeyHeader))
+			},
+		}),
+	)
+	require.NoError(t, err)
+
+	payment, err := client.CreatePayment(context.Background(), &CreatePaymentRequestOptions{Body: &CreatePaymentBody{Amount: 100}})
+	require.NoError(t, err)
+	assert.Equal(t, "1", payment.ID)
+
+	require.Len(t, keys, 2)
+	assert.Equal(t, keys[0], keys[1])
+	assert.Len(t, svc.payments, 1)
+
+	payment, err = client.CreatePayment(context.Background(), &CreatePaymentRequestOptions{Body: &CreatePaymentBody{Amount: 200}})
+	require.NoError(t, err)
+	assert.Equal(t, "2", payment.ID)
+}
+
+func TestIdempotentReplay(t *testing.T) {
+	svc := NewService()
+	client, err := NewDefaultClient("http://in-process",
+		runtime.WithHTTPClient(runtime.NewHandlerDoer(NewRouter(svc, WithIdempotencyStore(runtime.NewMemoryIdempotencyStore(0))))),
+	)
+	require.NoError(t, err)
+
+	ctx := runtime.WithIdempotencyKey(context.Background(), "order-42")
+	first, err := client.CreatePayment(ctx, &CreatePaymentRequestOptions{Body: &CreatePaymentBody{Amount: 100}})
+	require.NoError(t, err)
+	second, err := client.CreatePayment(ctx, &CreatePaymentRequestOptions{Body: &CreatePaymentBody{Amount: 100}})
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Len(t, svc.payments, 1)
+}
+
+func TestIdempotentKeyReused(t *testing.T) {
+	svc := NewService()
+	client, err := NewDefaultClient("http://in-process",
+		runtime.WithHTTPClient(runtime.NewHandlerDoer(NewRouter(svc, WithIdempotencyStore(runtime.NewMemoryIdempotencyStore(0))))),
+	)
+	require.NoError(t, err)
+
+	ctx := runtime.WithIdempotencyKey(context.Background(), "order-42")
+	_, err = client.CreatePayment(ctx, &CreatePaymentRequestOptions{Body: &CreatePaymentBody{Amount: 100}})
+	require.NoError(t, err)
+
+	_, err = client.CreatePayment(ctx, &CreatePaymentRequestOptions{Body: &CreatePaymentBody{Amount: 200}})
+	var apiErr *runtime.ClientAPIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode())
+	assert.Len(t, svc.payments, 1)
+}
+
+// pendingStore never completes the reserved keys, as if their requests were still in progress.
+type pendingStore struct {
+	runtime.IdempotencyStore
+}
+
+func (pendingStore) Complete(context.Context, string, *runtime.IdempotentResponse) error {
+	return nil
+}
+
+func TestIdempotentInProgress(t *testing.T) {
+	svc := NewService()
+	client, err := NewDefaultClient("http://in-process",
+		runtime.WithHTTPClient(runtime.NewHandlerDoer(NewRouter(svc, WithIdempotencyStore(pendingStore{runtime.NewMemoryIdempotencyStore(0)})))),
+	)
+	require.NoError(t, err)
+
+	ctx := runtime.WithIdempotencyKey(context.Background(), "order-42")
+	_, err = client.CreatePayment(ctx, &CreatePaymentRequestOptions{Body: &CreatePaymentBody{Amount: 100}})
+	require.NoError(t, err)
+
+	_, err = client.CreatePayment(ctx, &CreatePaymentRequestOptions{Body: &CreatePaymentBody{Amount: 100}})
+	var apiErr *runtime.ClientAPIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusConflict, apiErr.StatusCode())
+	assert.Len(t, svc.payments, 1)
+}
+
+// panicOnce panics on the first payment, before any response is written.
+type panicOnce struct {
+	*Service
+	panicked bool
+}
+
+func (s *panicOnce) CreatePayment(ctx context.Context, opts *CreatePaymentServiceRequestOptions) (*CreatePaymentResponseData, error) {
+	if !s.panicked {
+		s.panicked = true
+		panic("payment provider crashed")
+	}
+	return s.Service.CreatePayment(ctx, opts)
+}
+
+func TestIdempotentPanic(t *testing.T) {
+	svc := &panicOnce{Service: NewService()}
+	router := NewRouter(svc, WithIdempotencyStore(runtime.NewMemoryIdempotencyStore(0)))
+
+	serve := func() *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/payments", strings.NewReader(`{"amount":100}`))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set(runtime.IdempotencyKeyHeader, "order-42")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+
+	assert.PanicsWithValue(t, "payment provider crashed", func() { serve() })
+
+	w := serve()
+	assert.Empty(t, w.Header().Get(runtime.IdempotentReplayedHeader))
+	assert.JSONEq(t, `{"id":"1","amount":100}`, w.Body.String())
+	assert.Len(t, svc.payments, 1)
+}
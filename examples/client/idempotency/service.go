@@ -0,0 +1,31 @@
+package idempotency
+
+import (
+	"context"
+	"strconv"
+	"sync"
+)
+
+// Service implements the ServiceInterface with an in-memory store.
+type Service struct {
+	mu       sync.Mutex
+	payments []Payment
+}
+
+// NewService creates a new Service.
+func NewService() *Service {
+	return &Service{}
+}
+
+// Ensure Service implements ServiceInterface.
+var _ ServiceInterface = (*Service)(nil)
+
+// CreatePayment handles POST /payments
+func (s *Service) CreatePayment(ctx context.Context, opts *CreatePaymentServiceRequestOptions) (*CreatePaymentResponseData, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	payment := Payment{ID: strconv.Itoa(len(s.payments) + 1), Amount: opts.Body.Amount}
+	s.payments = append(s.payments, payment)
+	return NewCreatePaymentResponseData(&payment), nil
+}
@@ -0,0 +1,647 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package inprocess
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	GetUser(ctx context.Context, options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetUserResponse, error)
+
+	CreateUser(ctx context.Context, options *CreateUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateUserResponse, error)
+}
+
+func (c *Client) GetUser(ctx context.Context, options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetUserResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "GetUser", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/users/{id}",
+		Method:     "GET",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "GetUser",
+			Method: "GET",
+			Path:   "/users/{id}",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetUserResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			target := new(GetUserErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+					runtime.WithResponse(c.apiClient, resp))
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithResponse(c.apiClient, resp))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(GetUserResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetUser", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) CreateUser(ctx context.Context, options *CreateUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateUserResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "CreateUser", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/users",
+		Method:      "POST",
+		Options:     options,
+		ContentType: "application/json",
+		Operation: &runtime.OperationInfo{
+			ID:     "CreateUser",
+			Method: "POST",
+			Path:   "/users",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreateUserResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 201 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(CreateUserResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "CreateUser", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// NewInProcessClient creates a Client serving requests with NewRouter(svc) in the same process.
+// No socket is opened, which makes it suitable for end-to-end tests of the client and the service.
+func NewInProcessClient(svc ServiceInterface, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithHTTPClient(runtime.NewHandlerDoer(NewRouter(svc)))}, opts...)
+	return NewDefaultClient("http://in-process", opts...)
+}
+
+// GetUserRequestOptions is the options needed to make a request to GetUser.
+type GetUserRequestOptions struct {
+	PathParams *GetUserPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetUserRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetUserRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetUserRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *GetUserRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetUserRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// CreateUserRequestOptions is the options needed to make a request to CreateUser.
+type CreateUserRequestOptions struct {
+	Body *CreateUserBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreateUserRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreateUserRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreateUserRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *CreateUserRequestOptions) GetBody() any {
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreateUserRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// OapiErrorKind represents the type of error that occurred during request processing.
+type OapiErrorKind int
+
+const (
+	// OapiErrorKindParse indicates a parameter parsing error (invalid path/query/header parameter).
+	OapiErrorKindParse OapiErrorKind = iota
+
+	// OapiErrorKindDecode indicates a request body decoding error (invalid JSON, form data, etc.).
+	OapiErrorKindDecode
+
+	// OapiErrorKindValidation indicates a request validation error (failed schema validation).
+	OapiErrorKindValidation
+
+	// OapiErrorKindService indicates a service/business logic error returned by the service implementation.
+	OapiErrorKindService
+)
+
+// OapiHandlerError represents an error that occurred during request handling (parse, decode, validation).
+// When no typed error response is configured in the OpenAPI spec, this error type is used.
+// Custom error handlers can type-assert to this type to access error details.
+type OapiHandlerError struct {
+	Kind          OapiErrorKind
+	OperationID   string
+	Message       string
+	ParamName     string
+	ParamLocation string
+}
+
+func (e OapiHandlerError) Error() string {
+	return e.Message
+}
+
+// OapiErrorResponse is the default JSON error response structure used by OapiDefaultErrorHandler.
+type OapiErrorResponse struct {
+	Error         string `json:"error"`
+	OperationID   string `json:"operation_id,omitempty"`
+	ParamName     string `json:"param_name,omitempty"`
+	ParamLocation string `json:"param_location,omitempty"`
+}
+
+// OapiErrorHandler handles errors that occur during request processing.
+// Implement this interface to customize error responses, logging, and metrics.
+type OapiErrorHandler interface {
+	// HandleError writes an error response to w with the given status code.
+	// The err is either an OapiHandlerError (for parse/decode/validation errors)
+	// or a typed error matching the OpenAPI spec's error response schema.
+	HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error)
+}
+
+// OapiDefaultErrorHandler provides the default error handling behavior.
+// It writes JSON error responses. For OapiHandlerError, it uses OapiErrorResponse.
+// For typed errors (from OpenAPI spec), it encodes them directly.
+type OapiDefaultErrorHandler struct{}
+
+// HandleError implements OapiErrorHandler with default JSON error responses.
+func (h *OapiDefaultErrorHandler) HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if handlerErr, ok := err.(OapiHandlerError); ok {
+		_ = json.NewEncoder(w).Encode(OapiErrorResponse{
+			Error:         handlerErr.Message,
+			OperationID:   handlerErr.OperationID,
+			ParamName:     handlerErr.ParamName,
+			ParamLocation: handlerErr.ParamLocation,
+		})
+		return
+	}
+
+	// Typed error from OpenAPI spec - encode directly
+	_ = json.NewEncoder(w).Encode(err)
+}
+
+// ServiceInterface defines the service interface for business logic.
+type ServiceInterface interface {
+	GetUser(ctx context.Context, opts *GetUserServiceRequestOptions) (*GetUserResponseData, error)
+
+	CreateUser(ctx context.Context, opts *CreateUserServiceRequestOptions) (*CreateUserResponseData, error)
+}
+
+// HTTPAdapter adapts the ServiceInterface to HTTP handlers.
+// This struct is generated and should not be modified.
+type HTTPAdapter struct {
+	svc        ServiceInterface
+	errHandler OapiErrorHandler
+	codecs     *runtime.CodecRegistry
+}
+
+// NewHTTPAdapter creates a new HTTPAdapter wrapping the given service.
+// If errHandler is nil, OapiDefaultErrorHandler is used.
+func NewHTTPAdapter(svc ServiceInterface, errHandler OapiErrorHandler) *HTTPAdapter {
+	if errHandler == nil {
+		errHandler = &OapiDefaultErrorHandler{}
+	}
+	return &HTTPAdapter{svc: svc, errHandler: errHandler}
+}
+
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If codecs is nil, runtime.DefaultCodecs is used.
+func (a *HTTPAdapter) WithCodecs(codecs *runtime.CodecRegistry) *HTTPAdapter {
+	a.codecs = codecs
+	return a
+}
+
+// GetUser handles GET /users/{id}
+func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := &GetUserServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetUserPath{}
+	pathParamIDStr := r.PathValue("id")
+	pathParams.ID = pathParamIDStr
+	opts.PathParams = pathParams
+
+	// Call business logic
+	resp, err := a.svc.GetUser(ctx, opts)
+	if err != nil {
+		code := http.StatusInternalServerError
+		if _, ok := err.(*GetUserErrorResponse); ok {
+			code = 404
+		}
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// CreateUser handles POST /users
+func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := &CreateUserServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	defer r.Body.Close()
+	if err := runtime.DecompressRequestBody(r, 32<<20); err != nil {
+		a.errHandler.HandleError(w, r, runtime.DecompressErrorStatus(err, http.StatusBadRequest), OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreateUser",
+			Message:     err.Error(),
+		})
+		return
+	}
+	var body CreateUserBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreateUser",
+			Message:     err.Error(),
+		})
+		return
+	}
+	opts.Body = &body
+
+	// Call business logic
+	resp, err := a.svc.CreateUser(ctx, opts)
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 201
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// RouterOption is a function that configures the router.
+type RouterOption func(*routerConfig)
+
+type routerConfig struct {
+	middlewares []func(http.Handler) http.Handler
+	errHandler  OapiErrorHandler
+	codecs      *runtime.CodecRegistry
+}
+
+// WithMiddleware adds middleware to the router.
+func WithMiddleware(mw func(http.Handler) http.Handler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.middlewares = append(cfg.middlewares, mw)
+	}
+}
+
+// WithErrorHandler sets a custom error handler for the router.
+// If not set, OapiDefaultErrorHandler is used.
+func WithErrorHandler(h OapiErrorHandler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.errHandler = h
+	}
+}
+
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If not set, runtime.DefaultCodecs is used.
+func WithCodecs(codecs *runtime.CodecRegistry) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.codecs = codecs
+	}
+}
+
+// NewRouter creates a new http.ServeMux with the given service implementation.
+func NewRouter(svc ServiceInterface, opts ...RouterOption) *http.ServeMux {
+	cfg := &routerConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	adapter := NewHTTPAdapter(svc, cfg.errHandler).WithCodecs(cfg.codecs)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/{id}", applyMiddleware(http.HandlerFunc(adapter.GetUser), cfg.middlewares...))
+	mux.HandleFunc("POST /users", applyMiddleware(http.HandlerFunc(adapter.CreateUser), cfg.middlewares...))
+
+	return mux
+}
+
+// applyMiddleware wraps a handler with the given middleware chain.
+func applyMiddleware(h http.Handler, middlewares ...func(http.Handler) http.Handler) http.HandlerFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h.ServeHTTP
+}
+
+type GetUserPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetUserPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type CreateUserBody struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (c CreateUserBody) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(c))
+}
+
+// GetUserResponseData wraps the success response with optional headers and status override.
+type GetUserResponseData struct {
+	Body    *GetUserResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetUserResponseData creates a new GetUserResponseData with the given body.
+func NewGetUserResponseData(body *GetUserResponse) *GetUserResponseData {
+	return &GetUserResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetUserResponseData) WithHeaders(h http.Header) *GetUserResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetUserResponseData) WithStatus(code int) *GetUserResponseData {
+	r.Status = code
+	return r
+}
+
+// CreateUserResponseData wraps the success response with optional headers and status override.
+type CreateUserResponseData struct {
+	Body    *CreateUserResponse
+	Headers http.Header
+	Status  int // 0 = use default (201)
+}
+
+// NewCreateUserResponseData creates a new CreateUserResponseData with the given body.
+func NewCreateUserResponseData(body *CreateUserResponse) *CreateUserResponseData {
+	return &CreateUserResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *CreateUserResponseData) WithHeaders(h http.Header) *CreateUserResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *CreateUserResponseData) WithStatus(code int) *CreateUserResponseData {
+	r.Status = code
+	return r
+}
+
+type GetUserResponse = User
+
+type GetUserErrorResponse struct {
+	Message string `json:"message" validate:"required"`
+}
+
+func (r GetUserErrorResponse) Error() string {
+	res0 := r.Message
+	return res0
+}
+
+func NewGetUserErrorResponse(message string) GetUserErrorResponse {
+	return GetUserErrorResponse{Message: message}
+}
+
+type CreateUserResponse = User
+
+// GetUserServiceRequestOptions holds all parameters for the GetUser operation.
+type GetUserServiceRequestOptions struct {
+	PathParams *GetUserPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetUserServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// CreateUserServiceRequestOptions holds all parameters for the CreateUser operation.
+type CreateUserServiceRequestOptions struct {
+	Body *CreateUserBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *CreateUserServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+type User struct {
+	ID   string `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func (u User) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
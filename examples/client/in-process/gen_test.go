@@ -0,0 +1,49 @@
+package inprocess
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+func TestInProcessClient(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := NewInProcessClient(NewService())
+	require.NoError(t, err)
+
+	created, err := client.CreateUser(ctx, &CreateUserRequestOptions{Body: &CreateUserBody{Name: "Ada"}})
+	require.NoError(t, err)
+	assert.Equal(t, "1", created.ID)
+
+	user, err := client.GetUser(ctx, &GetUserRequestOptions{PathParams: &GetUserPath{ID: created.ID}})
+	require.NoError(t, err)
+	assert.Equal(t, "Ada", user.Name)
+
+	_, err = client.GetUser(ctx, &GetUserRequestOptions{PathParams: &GetUserPath{ID: "42"}})
+	var apiErr *runtime.ClientAPIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+
+	var notFound GetUserErrorResponse
+	require.True(t, errors.As(err, &notFound))
+	assert.Equal(t, "user 42 not found", notFound.Message)
+}
+
+func TestInProcessClient_options(t *testing.T) {
+	var paths []string
+	client, err := NewInProcessClient(NewService(), runtime.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		paths = append(paths, req.Method+" "+req.URL.Path)
+		return nil
+	}))
+	require.NoError(t, err)
+
+	_, err = client.CreateUser(context.Background(), &CreateUserRequestOptions{Body: &CreateUserBody{Name: "Grace"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"POST /users"}, paths)
+}
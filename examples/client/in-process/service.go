@@ -0,0 +1,43 @@
+package inprocess
+
+import (
+	"context"
+	"strconv"
+	"sync"
+)
+
+// Service implements the ServiceInterface with an in-memory store.
+type Service struct {
+	mu    sync.Mutex
+	users map[string]User
+}
+
+// NewService creates a new Service.
+func NewService() *Service {
+	return &Service{users: map[string]User{}}
+}
+
+// Ensure Service implements ServiceInterface.
+var _ ServiceInterface = (*Service)(nil)
+
+// GetUser handles GET /users/{id}
+func (s *Service) GetUser(ctx context.Context, opts *GetUserServiceRequestOptions) (*GetUserResponseData, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[opts.PathParams.ID]
+	if !ok {
+		return nil, &GetUserErrorResponse{Message: "user " + opts.PathParams.ID + " not found"}
+	}
+	return NewGetUserResponseData(&user), nil
+}
+
+// CreateUser handles POST /users
+func (s *Service) CreateUser(ctx context.Context, opts *CreateUserServiceRequestOptions) (*CreateUserResponseData, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user := User{ID: strconv.Itoa(len(s.users) + 1), Name: opts.Body.Name}
+	s.users[user.ID] = user
+	return NewCreateUserResponseData(&user), nil
+}
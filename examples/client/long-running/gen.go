@@ -0,0 +1,379 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package longrunning
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	CreateReport(ctx context.Context, options *CreateReportRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error)
+	// CreateReportAndWait calls CreateReport and polls GetReportOperation until the operation completes.
+	CreateReportAndWait(ctx context.Context, options *CreateReportRequestOptions, pollOpts *runtime.PollOptions, reqEditors ...runtime.RequestEditorFn) (*Report, error)
+
+	GetReportOperation(ctx context.Context, options *GetReportOperationRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetReportOperationResponse, error)
+}
+
+func (c *Client) CreateReport(ctx context.Context, options *CreateReportRequestOptions, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "CreateReport", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/reports",
+		Method:      "POST",
+		Options:     options,
+		ContentType: "application/json",
+		Operation: &runtime.OperationInfo{
+			ID:     "CreateReport",
+			Method: "POST",
+			Path:   "/reports",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*struct{}, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 202 {
+			target := new(CreateReportErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+					runtime.WithResponse(c.apiClient, resp))
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithResponse(c.apiClient, resp))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		return new(struct{}), nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/reports")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	runtime.CaptureResponse(ctx, req, resp)
+	return responseParser(ctx, resp)
+}
+
+// CreateReportAndWait calls CreateReport and polls GetReportOperation until the operation completes,
+// at the URL from the Operation-Location or Location header of the response.
+// Polls are delayed according to pollOpts and the Retry-After header, and stop on the cancellation of ctx.
+// Transient poll errors, such as 503 responses, are followed by another poll.
+// A failure state is returned as a *runtime.OperationFailedError.
+func (c *Client) CreateReportAndWait(ctx context.Context, options *CreateReportRequestOptions, pollOpts *runtime.PollOptions, reqEditors ...runtime.RequestEditorFn) (*Report, error) {
+	var captured runtime.CapturedResponse
+	if _, err := c.CreateReport(runtime.WithCapturedResponse(ctx, &captured), options, reqEditors...); err != nil {
+		return nil, err
+	}
+	resp := captured.Response
+
+	location := runtime.OperationLocation(resp.Headers, captured.Request.URL)
+	if location == "" {
+		return nil, runtime.ErrNoOperationLocation
+	}
+
+	return runtime.PollUntilDone(ctx, pollOpts, resp, func(ctx context.Context) (*runtime.Response, *Report, bool, error) {
+		var err error
+		req, err := c.apiClient.CreateRequest(ctx, runtime.RequestOptionsParameters{
+			RequestURL: location,
+			Method:     "GET",
+			Operation: &runtime.OperationInfo{
+				ID:     "GetReportOperation",
+				Method: "GET",
+				Path:   "/operations/{id}",
+			},
+		}, reqEditors...)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("error creating request: %w", err)
+		}
+
+		responseParser := func(ctx context.Context, resp *runtime.Response) (*GetReportOperationResponse, error) {
+			bodyBytes := resp.Content
+			if resp.StatusCode != 200 {
+				return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+					runtime.WithResponse(c.apiClient, resp))
+			}
+			target := new(GetReportOperationResponse)
+			if err = json.Unmarshal(bodyBytes, target); err != nil {
+				return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+					runtime.WithResponse(c.apiClient, resp))
+			}
+			if err = runtime.ValidateResponse(c.apiClient, "GetReportOperation", resp.StatusCode, target); err != nil {
+				return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+			}
+			return target, nil
+		}
+
+		resp, err := c.apiClient.ExecuteRequest(ctx, req, "/operations/{id}")
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("error executing request: %w", err)
+		}
+		status, err := responseParser(ctx, resp)
+		if err != nil {
+			return resp, nil, false, err
+		}
+		state := string(status.Status)
+		switch state {
+		case "succeeded":
+			return resp, status.Report, true, nil
+		case "failed", "canceled":
+			return resp, nil, true, &runtime.OperationFailedError{OperationID: "CreateReport", State: state}
+		}
+		return resp, nil, false, nil
+	})
+}
+
+func (c *Client) GetReportOperation(ctx context.Context, options *GetReportOperationRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetReportOperationResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "GetReportOperation", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/operations/{id}",
+		Method:     "GET",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "GetReportOperation",
+			Method: "GET",
+			Path:   "/operations/{id}",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetReportOperationResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(GetReportOperationResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetReportOperation", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/operations/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// CreateReportRequestOptions is the options needed to make a request to CreateReport.
+type CreateReportRequestOptions struct {
+	Body *CreateReportBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreateReportRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreateReportRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreateReportRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *CreateReportRequestOptions) GetBody() any {
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreateReportRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// GetReportOperationRequestOptions is the options needed to make a request to GetReportOperation.
+type GetReportOperationRequestOptions struct {
+	PathParams *GetReportOperationPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetReportOperationRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetReportOperationRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetReportOperationRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *GetReportOperationRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetReportOperationRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type ReportOperationStatus string
+
+const (
+	Canceled  ReportOperationStatus = "canceled"
+	Failed    ReportOperationStatus = "failed"
+	Running   ReportOperationStatus = "running"
+	Succeeded ReportOperationStatus = "succeeded"
+)
+
+// Validate checks if the ReportOperationStatus value is valid
+func (r ReportOperationStatus) Validate() error {
+	switch r {
+	case Canceled, Failed, Running, Succeeded:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid ReportOperationStatus value, got: %v", r))
+	}
+}
+
+type GetReportOperationPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetReportOperationPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type CreateReportBody struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func (c CreateReportBody) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(c))
+}
+
+type CreateReportErrorResponse = Error
+
+type GetReportOperationResponse = ReportOperation
+
+type ReportOperation struct {
+	Status ReportOperationStatus `json:"status" validate:"required"`
+	Report *Report               `json:"report,omitempty"`
+}
+
+func (r ReportOperation) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := any(r.Status).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Status", err)
+		}
+	}
+	if r.Report != nil {
+		if v, ok := any(r.Report).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Report", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Report struct {
+	ID  *string `json:"id,omitempty"`
+	URL *string `json:"url,omitempty"`
+}
+
+type Error struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,128 @@
+package longrunning
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewDefaultClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return client
+}
+
+// newReportServer accepts report creations and reports the given states on each poll.
+// The "unavailable" state answers 503.
+func newReportServer(t *testing.T, states ...string) (*Client, *int) {
+	polls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/reports":
+			w.Header().Set("Operation-Location", "/operations/op-1")
+			w.WriteHeader(http.StatusAccepted)
+		case "/operations/op-1":
+			state := states[min(polls, len(states)-1)]
+			polls++
+			if state == "unavailable" {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			if state != "succeeded" {
+				w.Header().Set("Retry-After", "0")
+				_, _ = w.Write([]byte(`{"status":"` + state + `"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"status":"succeeded","report":{"id":"r-1","url":"https://example.com/r-1"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	return client, &polls
+}
+
+func TestCreateReportAndWait(t *testing.T) {
+	pollOpts := &runtime.PollOptions{InitialInterval: time.Millisecond}
+	options := &CreateReportRequestOptions{Body: &CreateReportBody{Name: "sales"}}
+
+	t.Run("returns the result", func(t *testing.T) {
+		client, polls := newReportServer(t, "running", "running", "succeeded")
+
+		report, err := client.CreateReportAndWait(context.Background(), options, pollOpts)
+		require.NoError(t, err)
+		require.NotNil(t, report)
+		assert.Equal(t, "r-1", *report.ID)
+		assert.Equal(t, 3, *polls)
+	})
+
+	t.Run("keeps polling after unavailable", func(t *testing.T) {
+		client, polls := newReportServer(t, "running", "unavailable", "succeeded")
+
+		report, err := client.CreateReportAndWait(context.Background(), options, pollOpts)
+		require.NoError(t, err)
+		assert.Equal(t, "r-1", *report.ID)
+		assert.Equal(t, 3, *polls)
+	})
+
+	t.Run("failure state", func(t *testing.T) {
+		client, _ := newReportServer(t, "running", "failed")
+
+		_, err := client.CreateReportAndWait(context.Background(), options, pollOpts)
+		var failed *runtime.OperationFailedError
+		require.ErrorAs(t, err, &failed)
+		assert.Equal(t, "CreateReport", failed.OperationID)
+		assert.Equal(t, "failed", failed.State)
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		client, _ := newReportServer(t, "running")
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := client.CreateReportAndWait(ctx, options, pollOpts)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"invalid name"}`))
+		})
+
+		_, err := client.CreateReportAndWait(context.Background(), options, pollOpts)
+		var apiErr *runtime.ClientAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+	})
+
+	t.Run("no operation location", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+		})
+
+		_, err := client.CreateReportAndWait(context.Background(), options, pollOpts)
+		assert.ErrorIs(t, err, runtime.ErrNoOperationLocation)
+	})
+}
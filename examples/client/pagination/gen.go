@@ -0,0 +1,622 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package pagination
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"slices"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	ListUsers(ctx context.Context, options *ListUsersRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListUsersResponse, error)
+	// ListUsersAll iterates over the items of all pages of ListUsers.
+	ListUsersAll(ctx context.Context, options *ListUsersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[User, error]
+
+	ListOrders(ctx context.Context, options *ListOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListOrdersResponse, error)
+	// ListOrdersAll iterates over the items of all pages of ListOrders.
+	ListOrdersAll(ctx context.Context, options *ListOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[Order, error]
+
+	ListEvents(ctx context.Context, options *ListEventsRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListEventsResponse, error)
+	// ListEventsAll iterates over the items of all pages of ListEvents.
+	ListEventsAll(ctx context.Context, options *ListEventsRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[string, error]
+
+	ListLogs(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListLogsResponse, error)
+	// ListLogsAll iterates over the items of all pages of ListLogs.
+	ListLogsAll(ctx context.Context, reqEditors ...runtime.RequestEditorFn) iter.Seq2[string, error]
+}
+
+func (c *Client) ListUsers(ctx context.Context, options *ListUsersRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListUsersResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "ListUsers", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/users",
+		Method:     "GET",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "ListUsers",
+			Method: "GET",
+			Path:   "/users",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListUsersResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(ListUsersResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "ListUsers", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// ListUsersAll iterates over the items of all pages of ListUsers, fetching the pages lazily.
+// It stops on the first error, including the cancellation of ctx.
+func (c *Client) ListUsersAll(ctx context.Context, options *ListUsersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[User, error] {
+	return func(yield func(User, error) bool) {
+		var zero User
+		var opts ListUsersRequestOptions
+		if options != nil {
+			opts = *options
+		}
+		var query ListUsersQuery
+		if opts.Query != nil {
+			query = *opts.Query
+		}
+		opts.Query = &query
+		seen := make(map[string]bool)
+		if query.Cursor != nil {
+			seen[*query.Cursor] = true
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			page, err := c.ListUsers(ctx, &opts, reqEditors...)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			items := page.Data
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if page.NextCursor == nil {
+				return
+			}
+			next := *page.NextCursor
+			if next == "" {
+				return
+			}
+			if seen[next] {
+				yield(zero, fmt.Errorf("%w: cursor %v", runtime.ErrPaginationLoop, next))
+				return
+			}
+			seen[next] = true
+			query.Cursor = &next
+		}
+	}
+}
+
+func (c *Client) ListOrders(ctx context.Context, options *ListOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListOrdersResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "ListOrders", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/orders",
+		Method:     "GET",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "ListOrders",
+			Method: "GET",
+			Path:   "/orders",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListOrdersResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(ListOrdersResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "ListOrders", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/orders")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// ListOrdersAll iterates over the items of all pages of ListOrders, fetching the pages lazily.
+// It stops on the first error, including the cancellation of ctx.
+func (c *Client) ListOrdersAll(ctx context.Context, options *ListOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[Order, error] {
+	return func(yield func(Order, error) bool) {
+		var zero Order
+		var opts ListOrdersRequestOptions
+		if options != nil {
+			opts = *options
+		}
+		var query ListOrdersQuery
+		if opts.Query != nil {
+			query = *opts.Query
+		}
+		opts.Query = &query
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			page, err := c.ListOrders(ctx, &opts, reqEditors...)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			items := page.Items
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(items) == 0 {
+				return
+			}
+			if query.Limit != nil && len(items) < int(*query.Limit) {
+				return
+			}
+
+			var current int
+			if query.Offset != nil {
+				current = *query.Offset
+			}
+			next := current + len(items)
+			query.Offset = &next
+		}
+	}
+}
+
+func (c *Client) ListEvents(ctx context.Context, options *ListEventsRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListEventsResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "ListEvents", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/events",
+		Method:     "GET",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "ListEvents",
+			Method: "GET",
+			Path:   "/events",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListEventsResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(ListEventsResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "ListEvents", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/events")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// ListEventsAll iterates over the items of all pages of ListEvents, fetching the pages lazily.
+// It stops on the first error, including the cancellation of ctx.
+func (c *Client) ListEventsAll(ctx context.Context, options *ListEventsRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		var zero string
+		var opts ListEventsRequestOptions
+		if options != nil {
+			opts = *options
+		}
+		var query ListEventsQuery
+		if opts.Query != nil {
+			query = *opts.Query
+		}
+		opts.Query = &query
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			page, err := c.ListEvents(ctx, &opts, reqEditors...)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			items := *page
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(items) == 0 {
+				return
+			}
+
+			current := 1
+			if query.Page != nil {
+				current = *query.Page
+			}
+			next := current + 1
+			query.Page = &next
+		}
+	}
+}
+
+func (c *Client) ListLogs(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListLogsResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/logs",
+		Method:     "GET",
+		Operation: &runtime.OperationInfo{
+			ID:     "ListLogs",
+			Method: "GET",
+			Path:   "/logs",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListLogsResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(ListLogsResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "ListLogs", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/logs")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	runtime.CaptureResponse(ctx, req, resp)
+	return responseParser(ctx, resp)
+}
+
+// ListLogsAll iterates over the items of all pages of ListLogs, fetching the pages lazily.
+// It stops on the first error, including the cancellation of ctx.
+func (c *Client) ListLogsAll(ctx context.Context, reqEditors ...runtime.RequestEditorFn) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		var zero string
+		var captured runtime.CapturedResponse
+		pageCtx := runtime.WithCapturedResponse(ctx, &captured)
+		seen := make(map[string]bool)
+		editors := reqEditors
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			page, err := c.ListLogs(pageCtx, editors...)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			items := page.Entries
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			current := captured.Request.URL
+			seen[current.String()] = true
+			next := runtime.NextLink(captured.Response.Headers, current)
+			if next == "" {
+				return
+			}
+			if seen[next] {
+				yield(zero, fmt.Errorf("%w: %s", runtime.ErrPaginationLoop, next))
+				return
+			}
+			editors = append(slices.Clip(reqEditors), runtime.WithRequestURL(next))
+		}
+	}
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// ListUsersRequestOptions is the options needed to make a request to ListUsers.
+type ListUsersRequestOptions struct {
+	Query *ListUsersQuery
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *ListUsersRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *ListUsersRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *ListUsersRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *ListUsersRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *ListUsersRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// ListOrdersRequestOptions is the options needed to make a request to ListOrders.
+type ListOrdersRequestOptions struct {
+	Query *ListOrdersQuery
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *ListOrdersRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *ListOrdersRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *ListOrdersRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *ListOrdersRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *ListOrdersRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// ListEventsRequestOptions is the options needed to make a request to ListEvents.
+type ListEventsRequestOptions struct {
+	Query *ListEventsQuery
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *ListEventsRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *ListEventsRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *ListEventsRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *ListEventsRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *ListEventsRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type ListUsersQuery struct {
+	Cursor *string `json:"cursor,omitempty"`
+	Limit  *int    `json:"limit,omitempty"`
+}
+
+type ListOrdersQuery struct {
+	Offset *int `json:"offset,omitempty"`
+	Limit  *int `json:"limit,omitempty"`
+}
+
+type ListEventsQuery struct {
+	Page *int `json:"page,omitempty"`
+}
+
+type ListUsersResponse = UserList
+
+type ListOrdersResponse struct {
+	Items []Order `json:"items,omitempty"`
+}
+
+type ListEventsResponse []string
+
+type ListLogsResponse struct {
+	Entries []string `json:"entries" validate:"required"`
+}
+
+type User struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (u User) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+type Order struct {
+	ID int `json:"id" validate:"required"`
+}
+
+func (o Order) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(o))
+}
+
+type UserList struct {
+	Data       []User  `json:"data" validate:"required"`
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+func (u UserList) Validate() error {
+	var errors runtime.ValidationErrors
+	for i, item := range u.Data {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Data[%d]", i), err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,211 @@
+package pagination
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewDefaultClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	return client
+}
+
+func writeJSON(w http.ResponseWriter, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(body))
+}
+
+func TestListUsersAll_Cursor(t *testing.T) {
+	pages := map[string]string{
+		"":   `{"data":[{"id":"1"},{"id":"2"}],"next_cursor":"c2"}`,
+		"c2": `{"data":[{"id":"3"}],"next_cursor":"c3"}`,
+		"c3": `{"data":[{"id":"4"}]}`,
+	}
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+		writeJSON(w, pages[r.URL.Query().Get("cursor")])
+	})
+
+	var ids []string
+	for user, err := range client.ListUsersAll(context.Background(), &ListUsersRequestOptions{
+		Query: &ListUsersQuery{Limit: runtime.Ptr(2)},
+	}) {
+		require.NoError(t, err)
+		ids = append(ids, user.ID)
+	}
+
+	assert.Equal(t, []string{"1", "2", "3", "4"}, ids)
+	assert.Equal(t, []string{"limit=2", "cursor=c2&limit=2", "cursor=c3&limit=2"}, requests)
+}
+
+func TestListOrdersAll_Offset(t *testing.T) {
+	var offsets []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+		switch offset {
+		case 0:
+			writeJSON(w, `{"items":[{"id":1},{"id":2}]}`)
+		case 2:
+			writeJSON(w, `{"items":[{"id":3}]}`)
+		default:
+			writeJSON(w, `{"items":[]}`)
+		}
+	})
+
+	t.Run("stops on a short page", func(t *testing.T) {
+		offsets = nil
+		var ids []int
+		for order, err := range client.ListOrdersAll(context.Background(), &ListOrdersRequestOptions{
+			Query: &ListOrdersQuery{Limit: runtime.Ptr(2)},
+		}) {
+			require.NoError(t, err)
+			ids = append(ids, order.ID)
+		}
+		assert.Equal(t, []int{1, 2, 3}, ids)
+		assert.Equal(t, []string{"", "2"}, offsets)
+	})
+
+	t.Run("stops on an empty page", func(t *testing.T) {
+		offsets = nil
+		var ids []int
+		for order, err := range client.ListOrdersAll(context.Background(), nil) {
+			require.NoError(t, err)
+			ids = append(ids, order.ID)
+		}
+		assert.Equal(t, []int{1, 2, 3}, ids)
+		assert.Equal(t, []string{"", "2", "3"}, offsets)
+	})
+}
+
+func TestListEventsAll_Page(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			writeJSON(w, `["a","b"]`)
+		case "2":
+			writeJSON(w, `["c"]`)
+		default:
+			writeJSON(w, `[]`)
+		}
+	})
+
+	var events []string
+	for event, err := range client.ListEventsAll(context.Background(), nil) {
+		require.NoError(t, err)
+		events = append(events, event)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, events)
+}
+
+func TestListLogsAll_Link(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page < 2 {
+			w.Header().Set("Link", fmt.Sprintf(`</logs?page=%d>; rel="next"`, page+1))
+		}
+		writeJSON(w, fmt.Sprintf(`{"entries":["entry %d"]}`, page))
+	})
+
+	var entries []string
+	for entry, err := range client.ListLogsAll(context.Background()) {
+		require.NoError(t, err)
+		entries = append(entries, entry)
+	}
+	assert.Equal(t, []string{"entry 0", "entry 1", "entry 2"}, entries)
+}
+
+func TestListUsersAll_Stops(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		writeJSON(w, `{"data":[{"id":"1"},{"id":"2"}],"next_cursor":"next"}`)
+	})
+
+	t.Run("on break", func(t *testing.T) {
+		requests = 0
+		for range client.ListUsersAll(context.Background(), nil) {
+			break
+		}
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("on context cancellation", func(t *testing.T) {
+		requests = 0
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var errs []error
+		for _, err := range client.ListUsersAll(ctx, nil) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			cancel()
+		}
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], context.Canceled)
+		assert.Equal(t, 1, requests)
+	})
+}
+
+func TestListAll_Loop(t *testing.T) {
+	t.Run("repeated cursor", func(t *testing.T) {
+		requests := 0
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			writeJSON(w, `{"data":[{"id":"1"}],"next_cursor":"same"}`)
+		})
+
+		var errs []error
+		for _, err := range client.ListUsersAll(context.Background(), nil) {
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], runtime.ErrPaginationLoop)
+		assert.Equal(t, 2, requests)
+	})
+
+	t.Run("repeated link", func(t *testing.T) {
+		requests := 0
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header().Set("Link", `</logs?page=1>; rel="next"`)
+			writeJSON(w, `{"entries":["entry"]}`)
+		})
+
+		var errs []error
+		for _, err := range client.ListLogsAll(context.Background()) {
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], runtime.ErrPaginationLoop)
+		assert.Equal(t, 2, requests)
+	})
+}
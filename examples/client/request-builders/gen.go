@@ -0,0 +1,383 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package requestbuilders
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	CreatePayment(ctx context.Context, options *CreatePaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePaymentResponse, error)
+
+	GetPayment(ctx context.Context, options *GetPaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetPaymentResponse, error)
+}
+
+func (c *Client) CreatePayment(ctx context.Context, options *CreatePaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreatePaymentResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "CreatePayment", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  c.apiClient.GetBaseURL() + "/payments",
+		Method:      "POST",
+		Options:     options,
+		ContentType: "application/json",
+		Operation: &runtime.OperationInfo{
+			ID:     "CreatePayment",
+			Method: "POST",
+			Path:   "/payments",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreatePaymentResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 201 {
+			target := new(CreatePaymentErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+					runtime.WithResponse(c.apiClient, resp))
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithResponse(c.apiClient, resp))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(CreatePaymentResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "CreatePayment", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/payments")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *Client) GetPayment(ctx context.Context, options *GetPaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetPaymentResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "GetPayment", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/payments/{id}",
+		Method:     "GET",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "GetPayment",
+			Method: "GET",
+			Path:   "/payments/{id}",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetPaymentResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(GetPaymentResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetPayment", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/payments/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// CreatePaymentRequestOptions is the options needed to make a request to CreatePayment.
+type CreatePaymentRequestOptions struct {
+	Body *CreatePaymentBody
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *CreatePaymentRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *CreatePaymentRequestOptions) GetPathParams() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetQuery returns the query params as a map.
+func (o *CreatePaymentRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *CreatePaymentRequestOptions) GetBody() any {
+	return o.Body
+}
+
+// GetHeader returns the headers as a map.
+func (o *CreatePaymentRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// GetPaymentRequestOptions is the options needed to make a request to GetPayment.
+type GetPaymentRequestOptions struct {
+	PathParams *GetPaymentPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetPaymentRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetPaymentRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetPaymentRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *GetPaymentRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetPaymentRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// NewCreatePaymentRequest builds the request of CreatePayment to the server at baseURL, without sending it.
+// It can be signed or batched, sent with any transport, and its response decoded with ParseCreatePaymentResponse.
+func NewCreatePaymentRequest(ctx context.Context, baseURL string, options *CreatePaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*http.Request, error) {
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL:  strings.TrimSuffix(baseURL, "/") + "/payments",
+		Method:      "POST",
+		Options:     options,
+		ContentType: "application/json",
+		Operation: &runtime.OperationInfo{
+			ID:     "CreatePayment",
+			Method: "POST",
+			Path:   "/payments",
+		},
+	}
+	return runtime.NewRequest(ctx, reqParams, reqEditors...)
+}
+
+// ParseCreatePaymentResponse decodes the response of CreatePayment, reading and closing its body.
+// Error responses are returned as a *runtime.ClientAPIError with the status code.
+func ParseCreatePaymentResponse(resp *http.Response) (*CreatePaymentResponse, error) {
+	var err error
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*CreatePaymentResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 201 {
+			target := new(CreatePaymentErrorResponse)
+			err = json.Unmarshal(bodyBytes, target)
+			if err != nil {
+				return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+					runtime.WithResponse(nil, resp))
+			}
+
+			if errTarget, ok := any(*target).(error); ok {
+				return nil, runtime.NewClientAPIError(errTarget, runtime.WithResponse(nil, resp))
+			}
+			return nil, runtime.NewClientAPIError(fmt.Errorf("API error (status %d): %v", resp.StatusCode, *target),
+				runtime.WithResponse(nil, resp))
+		}
+		target := new(CreatePaymentResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(nil, resp))
+		}
+		if err = runtime.ValidateResponse(nil, "CreatePayment", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(nil, resp))
+		}
+		return target, nil
+	}
+
+	res, err := runtime.ReadResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return responseParser(context.Background(), res)
+}
+
+// NewGetPaymentRequest builds the request of GetPayment to the server at baseURL, without sending it.
+// It can be signed or batched, sent with any transport, and its response decoded with ParseGetPaymentResponse.
+func NewGetPaymentRequest(ctx context.Context, baseURL string, options *GetPaymentRequestOptions, reqEditors ...runtime.RequestEditorFn) (*http.Request, error) {
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: strings.TrimSuffix(baseURL, "/") + "/payments/{id}",
+		Method:     "GET",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "GetPayment",
+			Method: "GET",
+			Path:   "/payments/{id}",
+		},
+	}
+	return runtime.NewRequest(ctx, reqParams, reqEditors...)
+}
+
+// ParseGetPaymentResponse decodes the response of GetPayment, reading and closing its body.
+// Error responses are returned as a *runtime.ClientAPIError with the status code.
+func ParseGetPaymentResponse(resp *http.Response) (*GetPaymentResponse, error) {
+	var err error
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetPaymentResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(nil, resp))
+		}
+		target := new(GetPaymentResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(nil, resp))
+		}
+		if err = runtime.ValidateResponse(nil, "GetPayment", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(nil, resp))
+		}
+		return target, nil
+	}
+
+	res, err := runtime.ReadResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return responseParser(context.Background(), res)
+}
+
+type GetPaymentPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetPaymentPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type CreatePaymentBody = NewPayment
+
+type CreatePaymentResponse = Payment
+
+type CreatePaymentErrorResponse = Error
+
+type GetPaymentResponse = Payment
+
+type NewPayment struct {
+	Amount   int    `json:"amount" validate:"required"`
+	Currency string `json:"currency" validate:"required"`
+}
+
+func (n NewPayment) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(n))
+}
+
+type Payment struct {
+	ID       string `json:"id" validate:"required"`
+	Amount   int    `json:"amount" validate:"required"`
+	Currency string `json:"currency" validate:"required"`
+}
+
+func (p Payment) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+type Error struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,105 @@
+package requestbuilders
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+var secret = []byte("secret")
+
+// sign sets the X-Signature header to the HMAC-SHA256 of the method, path and body of the request.
+func sign(req *http.Request) error {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(req.Method + " " + req.URL.Path + "\n"))
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return err
+		}
+		if _, err = io.Copy(mac, body); err != nil {
+			return err
+		}
+	}
+	req.Header.Set("X-Signature", hex.EncodeToString(mac.Sum(nil)))
+	return nil
+}
+
+func newSignedServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+		mac.Write(body)
+
+		w.Header().Set("Content-Type", "application/json")
+		if !hmac.Equal([]byte(hex.EncodeToString(mac.Sum(nil))), []byte(r.Header.Get("X-Signature"))) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"invalid signature"}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"pay-1","amount":10,"currency":"USD"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRequestBuilders(t *testing.T) {
+	server := newSignedServer(t)
+	options := &CreatePaymentRequestOptions{Body: &CreatePaymentBody{Amount: 10, Currency: "USD"}}
+
+	t.Run("build, sign, send and parse", func(t *testing.T) {
+		req, err := NewCreatePaymentRequest(context.Background(), server.URL+"/", options)
+		require.NoError(t, err)
+		assert.Equal(t, server.URL+"/payments", req.URL.String())
+		require.NoError(t, sign(req))
+
+		resp, err := server.Client().Do(req)
+		require.NoError(t, err)
+
+		payment, err := ParseCreatePaymentResponse(resp)
+		require.NoError(t, err)
+		assert.Equal(t, "pay-1", payment.ID)
+		assert.Equal(t, 10, payment.Amount)
+	})
+
+	t.Run("typed error response", func(t *testing.T) {
+		req, err := NewCreatePaymentRequest(context.Background(), server.URL, options)
+		require.NoError(t, err)
+
+		resp, err := server.Client().Do(req)
+		require.NoError(t, err)
+
+		_, err = ParseCreatePaymentResponse(resp)
+		var apiErr *runtime.ClientAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode())
+		var typed Error
+		require.ErrorAs(t, err, &typed)
+		assert.Equal(t, "invalid signature", *typed.Message)
+	})
+
+	t.Run("path parameters and editors", func(t *testing.T) {
+		req, err := NewGetPaymentRequest(context.Background(), "https://api.example.com", &GetPaymentRequestOptions{
+			PathParams: &GetPaymentPath{ID: "pay-1"},
+		}, func(_ context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer token")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, "https://api.example.com/payments/pay-1", req.URL.String())
+		assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+	})
+}
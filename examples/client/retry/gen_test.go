@@ -0,0 +1,84 @@
+package retry
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func TestCreatePayment_Retry(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"pay_1","amount":100}`))
+	}))
+	defer server.Close()
+
+	var attempts []runtime.RetryAttempt
+	client, err := NewDefaultClient(server.URL,
+		runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}),
+		runtime.WithRetryPolicy(runtime.RetryPolicy{
+			InitialBackoff: time.Millisecond,
+			OnAttempt: func(_ context.Context, a runtime.RetryAttempt) {
+				attempts = append(attempts, a)
+			},
+		}),
+	)
+	require.NoError(t, err)
+
+	payment, err := client.CreatePayment(context.Background(), &CreatePaymentRequestOptions{
+		Body: &CreatePaymentBody{Amount: 100},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "pay_1", *payment.ID)
+
+	assert.Equal(t, []string{`{"amount":100}`, `{"amount":100}`, `{"amount":100}`}, bodies)
+	require.Len(t, attempts, 3)
+	assert.True(t, attempts[0].Retry)
+	assert.True(t, attempts[1].Retry)
+	assert.False(t, attempts[2].Retry)
+}
+
+func TestSearchPayments_NotRetryable(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := NewDefaultClient(server.URL,
+		runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}),
+		runtime.WithRetryPolicy(runtime.RetryPolicy{InitialBackoff: time.Millisecond}),
+	)
+	require.NoError(t, err)
+
+	_, err = client.SearchPayments(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, 1, requests)
+}
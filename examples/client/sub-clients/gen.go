@@ -0,0 +1,503 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package subclients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+// The operations with tags are in sub-clients, returned by its methods.
+type Client struct {
+	apiClient runtime.APIClient
+	orders    *OrdersClient
+	users     *UsersClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{
+		apiClient: apiClient,
+		orders:    &OrdersClient{apiClient: apiClient},
+		users:     &UsersClient{apiClient: apiClient},
+	}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return NewClient(apiClient), nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	// Orders returns the client of the operations tagged orders.
+	Orders() OrdersClientInterface
+
+	// Users returns the client of the operations tagged users.
+	Users() UsersClientInterface
+
+	Health(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*struct{}, error)
+}
+
+// Orders returns the client of the operations tagged orders.
+func (c *Client) Orders() OrdersClientInterface {
+	return c.orders
+}
+
+// Users returns the client of the operations tagged users.
+func (c *Client) Users() UsersClientInterface {
+	return c.users
+}
+
+func (c *Client) Health(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*struct{}, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/health",
+		Method:     "GET",
+		Operation: &runtime.OperationInfo{
+			ID:     "Health",
+			Method: "GET",
+			Path:   "/health",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*struct{}, error) {
+		if resp.StatusCode != 204 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		return nil, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/health")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// OrdersClient is the client of the operations tagged orders, implementing the OrdersClientInterface interface.
+type OrdersClient struct {
+	apiClient runtime.APIClient
+}
+
+// OrdersClientInterface is the interface for the operations tagged orders.
+type OrdersClientInterface interface {
+	ListOrders(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListOrdersResponse, error)
+}
+
+func (c *OrdersClient) ListOrders(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*ListOrdersResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/orders",
+		Method:     "GET",
+		Operation: &runtime.OperationInfo{
+			ID:     "ListOrders",
+			Method: "GET",
+			Path:   "/orders",
+			Tags:   []string{"orders"},
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListOrdersResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(ListOrdersResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "ListOrders", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/orders")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ OrdersClientInterface = (*OrdersClient)(nil)
+
+// UsersClient is the client of the operations tagged users, implementing the UsersClientInterface interface.
+type UsersClient struct {
+	apiClient runtime.APIClient
+}
+
+// UsersClientInterface is the interface for the operations tagged users.
+type UsersClientInterface interface {
+	GetUser(ctx context.Context, options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetUserResponse, error)
+
+	ListUserOrders(ctx context.Context, options *ListUserOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListUserOrdersResponse, error)
+}
+
+func (c *UsersClient) GetUser(ctx context.Context, options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetUserResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "GetUser", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/users/{id}",
+		Method:     "GET",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "GetUser",
+			Method: "GET",
+			Path:   "/users/{id}",
+			Tags:   []string{"users"},
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetUserResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(GetUserResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetUser", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+func (c *UsersClient) ListUserOrders(ctx context.Context, options *ListUserOrdersRequestOptions, reqEditors ...runtime.RequestEditorFn) (*ListUserOrdersResponse, error) {
+	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "ListUserOrders", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/users/{id}/orders",
+		Method:     "GET",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "ListUserOrders",
+			Method: "GET",
+			Path:   "/users/{id}/orders",
+			Tags:   []string{"users", "orders"},
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*ListUserOrdersResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(ListUserOrdersResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "ListUserOrders", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users/{id}/orders")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ UsersClientInterface = (*UsersClient)(nil)
+
+// FakeClient is an in-memory implementation of ClientInterface for tests.
+// For each operation, <Op>Func is called if set, otherwise <Op>Err or <Op>Response is returned.
+// Operations without a programmed response return runtime.ErrFakeNotConfigured.
+// All calls are recorded with their request options.
+type FakeClient struct {
+	runtime.FakeCalls
+
+	ListOrdersFunc     func(ctx context.Context) (*ListOrdersResponse, error)
+	ListOrdersResponse *ListOrdersResponse
+	ListOrdersErr      error
+
+	GetUserFunc     func(ctx context.Context, options *GetUserRequestOptions) (*GetUserResponse, error)
+	GetUserResponse *GetUserResponse
+	GetUserErr      error
+
+	ListUserOrdersFunc     func(ctx context.Context, options *ListUserOrdersRequestOptions) (*ListUserOrdersResponse, error)
+	ListUserOrdersResponse *ListUserOrdersResponse
+	ListUserOrdersErr      error
+
+	HealthFunc     func(ctx context.Context) (*struct{}, error)
+	HealthResponse *struct{}
+	HealthErr      error
+}
+
+// Orders returns the fake itself, which implements the operations of all sub-clients.
+func (f *FakeClient) Orders() OrdersClientInterface {
+	return f
+}
+
+// Users returns the fake itself, which implements the operations of all sub-clients.
+func (f *FakeClient) Users() UsersClientInterface {
+	return f
+}
+
+// ListOrders records the call and returns the programmed response.
+func (f *FakeClient) ListOrders(ctx context.Context, _ ...runtime.RequestEditorFn) (*ListOrdersResponse, error) {
+	f.Record("ListOrders", nil)
+	switch {
+	case f.ListOrdersFunc != nil:
+		return f.ListOrdersFunc(ctx)
+	case f.ListOrdersErr != nil:
+		return nil, f.ListOrdersErr
+	case f.ListOrdersResponse != nil:
+		return f.ListOrdersResponse, nil
+	}
+	return nil, fmt.Errorf("%w: ListOrders", runtime.ErrFakeNotConfigured)
+}
+
+// GetUser records the call and returns the programmed response.
+func (f *FakeClient) GetUser(ctx context.Context, options *GetUserRequestOptions, _ ...runtime.RequestEditorFn) (*GetUserResponse, error) {
+	f.Record("GetUser", options)
+	switch {
+	case f.GetUserFunc != nil:
+		return f.GetUserFunc(ctx, options)
+	case f.GetUserErr != nil:
+		return nil, f.GetUserErr
+	case f.GetUserResponse != nil:
+		return f.GetUserResponse, nil
+	}
+	return nil, fmt.Errorf("%w: GetUser", runtime.ErrFakeNotConfigured)
+}
+
+// GetUserCalls returns the options of the recorded calls to GetUser.
+func (f *FakeClient) GetUserCalls() []*GetUserRequestOptions {
+	var res []*GetUserRequestOptions
+	for _, call := range f.CallsTo("GetUser") {
+		options, _ := call.Options.(*GetUserRequestOptions)
+		res = append(res, options)
+	}
+	return res
+}
+
+// ListUserOrders records the call and returns the programmed response.
+func (f *FakeClient) ListUserOrders(ctx context.Context, options *ListUserOrdersRequestOptions, _ ...runtime.RequestEditorFn) (*ListUserOrdersResponse, error) {
+	f.Record("ListUserOrders", options)
+	switch {
+	case f.ListUserOrdersFunc != nil:
+		return f.ListUserOrdersFunc(ctx, options)
+	case f.ListUserOrdersErr != nil:
+		return nil, f.ListUserOrdersErr
+	case f.ListUserOrdersResponse != nil:
+		return f.ListUserOrdersResponse, nil
+	}
+	return nil, fmt.Errorf("%w: ListUserOrders", runtime.ErrFakeNotConfigured)
+}
+
+// ListUserOrdersCalls returns the options of the recorded calls to ListUserOrders.
+func (f *FakeClient) ListUserOrdersCalls() []*ListUserOrdersRequestOptions {
+	var res []*ListUserOrdersRequestOptions
+	for _, call := range f.CallsTo("ListUserOrders") {
+		options, _ := call.Options.(*ListUserOrdersRequestOptions)
+		res = append(res, options)
+	}
+	return res
+}
+
+// Health records the call and returns the programmed response.
+func (f *FakeClient) Health(ctx context.Context, _ ...runtime.RequestEditorFn) (*struct{}, error) {
+	f.Record("Health", nil)
+	switch {
+	case f.HealthFunc != nil:
+		return f.HealthFunc(ctx)
+	case f.HealthErr != nil:
+		return nil, f.HealthErr
+	case f.HealthResponse != nil:
+		return f.HealthResponse, nil
+	}
+	return nil, nil
+}
+
+var _ ClientInterface = (*FakeClient)(nil)
+
+// GetUserRequestOptions is the options needed to make a request to GetUser.
+type GetUserRequestOptions struct {
+	PathParams *GetUserPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetUserRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetUserRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetUserRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *GetUserRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetUserRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// ListUserOrdersRequestOptions is the options needed to make a request to ListUserOrders.
+type ListUserOrdersRequestOptions struct {
+	PathParams *ListUserOrdersPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *ListUserOrdersRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *ListUserOrdersRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *ListUserOrdersRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *ListUserOrdersRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *ListUserOrdersRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+type GetUserPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetUserPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type ListUserOrdersPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (l ListUserOrdersPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(l))
+}
+
+type ListOrdersResponse []Order
+
+type GetUserResponse = User
+
+type ListUserOrdersResponse []Order
+
+type Order struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+func (o Order) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(o))
+}
+
+type User struct {
+	ID   string `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func (u User) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
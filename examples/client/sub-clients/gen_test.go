@@ -0,0 +1,113 @@
+package subclients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+// countUserOrders depends only on the users sub-client, which keeps its fakes small.
+func countUserOrders(ctx context.Context, users UsersClientInterface, id string) (int, error) {
+	orders, err := users.ListUserOrders(ctx, &ListUserOrdersRequestOptions{PathParams: &ListUserOrdersPath{ID: id}})
+	if err != nil {
+		return 0, err
+	}
+	return len(*orders), nil
+}
+
+func TestSubClients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/orders":
+			_ = json.NewEncoder(w).Encode([]Order{{ID: "1", UserID: "u1"}, {ID: "2", UserID: "u2"}})
+		case "/users/u1":
+			_ = json.NewEncoder(w).Encode(User{ID: "u1", Name: "Ada"})
+		case "/users/u1/orders":
+			_ = json.NewEncoder(w).Encode([]Order{{ID: "1", UserID: "u1"}})
+		case "/health":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewDefaultClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	orders, err := client.Orders().ListOrders(ctx)
+	require.NoError(t, err)
+	assert.Len(t, *orders, 2)
+
+	user, err := client.Users().GetUser(ctx, &GetUserRequestOptions{PathParams: &GetUserPath{ID: "u1"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Ada", user.Name)
+
+	count, err := countUserOrders(ctx, client.Users(), "u1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	_, err = client.Health(ctx)
+	require.NoError(t, err)
+}
+
+func TestSubClients_OperationInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	var ops []runtime.OperationInfo
+	client, err := NewDefaultClient(server.URL,
+		runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}),
+		runtime.WithInterceptors(func(ctx context.Context, op *runtime.OperationInfo, req *http.Request, next runtime.ExecuteRequestFn) (*runtime.Response, error) {
+			ops = append(ops, *op)
+			return next(ctx, req)
+		}),
+	)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.Users().ListUserOrders(ctx, &ListUserOrdersRequestOptions{PathParams: &ListUserOrdersPath{ID: "u1"}})
+	require.NoError(t, err)
+	_, err = client.Health(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, []runtime.OperationInfo{
+		{ID: "ListUserOrders", Method: http.MethodGet, Path: "/users/{id}/orders", Tags: []string{"users", "orders"}},
+		{ID: "Health", Method: http.MethodGet, Path: "/health"},
+	}, ops)
+}
+
+func TestSubClients_Fake(t *testing.T) {
+	fake := &FakeClient{
+		ListUserOrdersResponse: &ListUserOrdersResponse{{ID: "1", UserID: "u1"}, {ID: "2", UserID: "u1"}},
+	}
+
+	count, err := countUserOrders(context.Background(), fake.Users(), "u1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, "u1", fake.ListUserOrdersCalls()[0].PathParams.ID)
+}
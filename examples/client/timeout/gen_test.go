@@ -0,0 +1,101 @@
+package timeout
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+// newSlowServer answers after delay, or gives up when the client goes away.
+func newSlowServer(t *testing.T, delay time.Duration) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/reports/1" {
+			_, _ = w.Write([]byte(`[{"id":"1"}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"1"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClientTimeout(t *testing.T) {
+	server := newSlowServer(t, time.Second)
+
+	client, err := NewDefaultClient(server.URL)
+	require.NoError(t, err)
+
+	_, err = client.ListReports(context.Background())
+	var netErr net.Error
+	require.ErrorAs(t, err, &netErr)
+	assert.True(t, netErr.Timeout())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClientTimeout_HTTPClient(t *testing.T) {
+	server := newSlowServer(t, time.Second)
+
+	client, err := NewDefaultClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+
+	_, err = client.ListReports(context.Background())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClientTimeout_Override(t *testing.T) {
+	server := newSlowServer(t, 300*time.Millisecond)
+
+	client, err := NewDefaultClient(server.URL, runtime.WithTimeout(time.Second))
+	require.NoError(t, err)
+
+	reports, err := client.ListReports(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, *reports, 1)
+}
+
+func TestOperationTimeout(t *testing.T) {
+	server := newSlowServer(t, 100*time.Millisecond)
+
+	client, err := NewDefaultClient(server.URL, runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}))
+	require.NoError(t, err)
+
+	_, err = client.GetReport(context.Background(), &GetReportRequestOptions{PathParams: &GetReportPath{ID: "1"}})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	reports, err := client.ListReports(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, *reports, 1)
+}
+
+func TestOperationTimeout_ReplacesClientTimeout(t *testing.T) {
+	server := newSlowServer(t, 300*time.Millisecond)
+
+	client, err := NewDefaultClient(server.URL)
+	require.NoError(t, err)
+
+	reports, err := client.ExportReports(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, *reports, 1)
+}
@@ -0,0 +1,99 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newTestClient(t *testing.T, body string, opts ...runtime.APIClientOption) (*Client, *int) {
+	t.Helper()
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	opts = append([]runtime.APIClientOption{runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()})}, opts...)
+	client, err := NewDefaultClient(server.URL, opts...)
+	require.NoError(t, err)
+	return client, &requests
+}
+
+func TestRequestValidation(t *testing.T) {
+	options := &ListUsersRequestOptions{Query: &ListUsersQuery{Limit: runtime.Ptr(500)}}
+
+	t.Run("invalid options are not sent", func(t *testing.T) {
+		client, requests := newTestClient(t, `[]`, runtime.WithRequestValidation())
+
+		_, err := client.ListUsers(context.Background(), options)
+
+		var reqErr *runtime.RequestValidationError
+		require.ErrorAs(t, err, &reqErr)
+		assert.Equal(t, "ListUsers", reqErr.OperationID)
+
+		var ves runtime.ValidationErrors
+		require.ErrorAs(t, err, &ves)
+		assert.Equal(t, "Query.Limit", ves[0].Field)
+		assert.Zero(t, *requests)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		client, requests := newTestClient(t, `[]`)
+
+		_, err := client.ListUsers(context.Background(), options)
+		require.NoError(t, err)
+		assert.Equal(t, 1, *requests)
+	})
+}
+
+func TestResponseValidation(t *testing.T) {
+	body := `{"id":"1","name":""}`
+
+	t.Run("invalid response", func(t *testing.T) {
+		client, _ := newTestClient(t, body, runtime.WithResponseValidation())
+
+		_, err := client.GetUser(context.Background(), &GetUserRequestOptions{PathParams: &GetUserPath{ID: "1"}})
+
+		var respErr *runtime.ResponseValidationError
+		require.ErrorAs(t, err, &respErr)
+		assert.Equal(t, "GetUser", respErr.OperationID)
+		assert.Equal(t, http.StatusOK, respErr.StatusCode)
+		assert.False(t, errors.As(err, new(*runtime.RequestValidationError)))
+	})
+
+	t.Run("array response", func(t *testing.T) {
+		client, _ := newTestClient(t, `[`+body+`]`, runtime.WithResponseValidation())
+
+		_, err := client.ListUsers(context.Background(), &ListUsersRequestOptions{})
+
+		var ves runtime.ValidationErrors
+		require.ErrorAs(t, err, &ves)
+		assert.Equal(t, "[0].Name", ves[0].Field)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		client, _ := newTestClient(t, body)
+
+		user, err := client.GetUser(context.Background(), &GetUserRequestOptions{PathParams: &GetUserPath{ID: "1"}})
+		require.NoError(t, err)
+		assert.Equal(t, "1", user.ID)
+	})
+}
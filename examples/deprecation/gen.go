@@ -0,0 +1,665 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package deprecation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	// GetUser Get a user.
+	//
+	// Deprecated: Use getAccount instead.
+	GetUser(ctx context.Context, options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetUserResponse, error)
+
+	// GetAccount Get an account.
+	GetAccount(ctx context.Context, options *GetAccountRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetAccountResponse, error)
+}
+
+// GetUser Get a user.
+//
+// Deprecated: Use getAccount instead.
+func (c *Client) GetUser(ctx context.Context, options *GetUserRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetUserResponse, error) {
+	var err error
+	runtime.ObserveDeprecation(ctx, c.apiClient, runtime.Deprecation{
+		OperationID: "GetUser",
+		Method:      "GET",
+		Path:        "/users/{id}",
+		Sunset:      "Thu, 31 Dec 2026 00:00:00 GMT",
+	})
+	if err = runtime.ValidateRequest(c.apiClient, "GetUser", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/users/{id}",
+		Method:     "GET",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "GetUser",
+			Method: "GET",
+			Path:   "/users/{id}",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetUserResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(GetUserResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetUser", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+// GetAccount Get an account.
+func (c *Client) GetAccount(ctx context.Context, options *GetAccountRequestOptions, reqEditors ...runtime.RequestEditorFn) (*GetAccountResponse, error) {
+	var err error
+	if options != nil && options.Query != nil && options.Query.Fields != nil {
+		runtime.ObserveDeprecation(ctx, c.apiClient, runtime.Deprecation{
+			OperationID: "GetAccount",
+			Method:      "GET",
+			Path:        "/accounts/{id}",
+			Parameter:   "fields",
+			In:          "query",
+		})
+	}
+	if err = runtime.ValidateRequest(c.apiClient, "GetAccount", options); err != nil {
+		return nil, err
+	}
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/accounts/{id}",
+		Method:     "GET",
+		Options:    options,
+		Operation: &runtime.OperationInfo{
+			ID:     "GetAccount",
+			Method: "GET",
+			Path:   "/accounts/{id}",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetAccountResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(GetAccountResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetAccount", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/accounts/{id}")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// NewInProcessClient creates a Client serving requests with NewRouter(svc) in the same process.
+// No socket is opened, which makes it suitable for end-to-end tests of the client and the service.
+func NewInProcessClient(svc ServiceInterface, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithHTTPClient(runtime.NewHandlerDoer(NewRouter(svc)))}, opts...)
+	return NewDefaultClient("http://in-process", opts...)
+}
+
+// GetUserRequestOptions is the options needed to make a request to GetUser.
+type GetUserRequestOptions struct {
+	PathParams *GetUserPath
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetUserRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetUserRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetUserRequestOptions) GetQuery() (map[string]any, error) {
+	return nil, nil
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *GetUserRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetUserRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// GetAccountRequestOptions is the options needed to make a request to GetAccount.
+type GetAccountRequestOptions struct {
+	PathParams *GetAccountPath
+	Query      *GetAccountQuery
+}
+
+// Validate validates all the fields in the options.
+// Use it if fields validation was not run.
+func (o *GetAccountRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPathParams returns the path params as a map.
+func (o *GetAccountRequestOptions) GetPathParams() (map[string]any, error) {
+	return runtime.AsMap[any](o.PathParams)
+}
+
+// GetQuery returns the query params as a map.
+func (o *GetAccountRequestOptions) GetQuery() (map[string]any, error) {
+	return runtime.AsMap[any](o.Query)
+}
+
+// GetBody returns the payload in any type that can be marshalled to JSON by the client.
+func (o *GetAccountRequestOptions) GetBody() any {
+	return nil
+}
+
+// GetHeader returns the headers as a map.
+func (o *GetAccountRequestOptions) GetHeader() (map[string]string, error) {
+	return nil, nil
+}
+
+// OapiErrorKind represents the type of error that occurred during request processing.
+type OapiErrorKind int
+
+const (
+	// OapiErrorKindParse indicates a parameter parsing error (invalid path/query/header parameter).
+	OapiErrorKindParse OapiErrorKind = iota
+
+	// OapiErrorKindDecode indicates a request body decoding error (invalid JSON, form data, etc.).
+	OapiErrorKindDecode
+
+	// OapiErrorKindValidation indicates a request validation error (failed schema validation).
+	OapiErrorKindValidation
+
+	// OapiErrorKindService indicates a service/business logic error returned by the service implementation.
+	OapiErrorKindService
+)
+
+// OapiHandlerError represents an error that occurred during request handling (parse, decode, validation).
+// When no typed error response is configured in the OpenAPI spec, this error type is used.
+// Custom error handlers can type-assert to this type to access error details.
+type OapiHandlerError struct {
+	Kind          OapiErrorKind
+	OperationID   string
+	Message       string
+	ParamName     string
+	ParamLocation string
+}
+
+func (e OapiHandlerError) Error() string {
+	return e.Message
+}
+
+// OapiErrorResponse is the default JSON error response structure used by OapiDefaultErrorHandler.
+type OapiErrorResponse struct {
+	Error         string `json:"error"`
+	OperationID   string `json:"operation_id,omitempty"`
+	ParamName     string `json:"param_name,omitempty"`
+	ParamLocation string `json:"param_location,omitempty"`
+}
+
+// OapiErrorHandler handles errors that occur during request processing.
+// Implement this interface to customize error responses, logging, and metrics.
+type OapiErrorHandler interface {
+	// HandleError writes an error response to w with the given status code.
+	// The err is either an OapiHandlerError (for parse/decode/validation errors)
+	// or a typed error matching the OpenAPI spec's error response schema.
+	HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error)
+}
+
+// OapiDefaultErrorHandler provides the default error handling behavior.
+// It writes JSON error responses. For OapiHandlerError, it uses OapiErrorResponse.
+// For typed errors (from OpenAPI spec), it encodes them directly.
+type OapiDefaultErrorHandler struct{}
+
+// HandleError implements OapiErrorHandler with default JSON error responses.
+func (h *OapiDefaultErrorHandler) HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if handlerErr, ok := err.(OapiHandlerError); ok {
+		_ = json.NewEncoder(w).Encode(OapiErrorResponse{
+			Error:         handlerErr.Message,
+			OperationID:   handlerErr.OperationID,
+			ParamName:     handlerErr.ParamName,
+			ParamLocation: handlerErr.ParamLocation,
+		})
+		return
+	}
+
+	// Typed error from OpenAPI spec - encode directly
+	_ = json.NewEncoder(w).Encode(err)
+}
+
+// ServiceInterface defines the service interface for business logic.
+type ServiceInterface interface {
+	// GetUser Get a user.
+	//
+	// Deprecated: Use getAccount instead.
+	GetUser(ctx context.Context, opts *GetUserServiceRequestOptions) (*GetUserResponseData, error)
+	// GetAccount Get an account.
+	GetAccount(ctx context.Context, opts *GetAccountServiceRequestOptions) (*GetAccountResponseData, error)
+}
+
+// HTTPAdapter adapts the ServiceInterface to HTTP handlers.
+// This struct is generated and should not be modified.
+type HTTPAdapter struct {
+	svc        ServiceInterface
+	errHandler OapiErrorHandler
+	codecs     *runtime.CodecRegistry
+}
+
+// NewHTTPAdapter creates a new HTTPAdapter wrapping the given service.
+// If errHandler is nil, OapiDefaultErrorHandler is used.
+func NewHTTPAdapter(svc ServiceInterface, errHandler OapiErrorHandler) *HTTPAdapter {
+	if errHandler == nil {
+		errHandler = &OapiDefaultErrorHandler{}
+	}
+	return &HTTPAdapter{svc: svc, errHandler: errHandler}
+}
+
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If codecs is nil, runtime.DefaultCodecs is used.
+func (a *HTTPAdapter) WithCodecs(codecs *runtime.CodecRegistry) *HTTPAdapter {
+	a.codecs = codecs
+	return a
+}
+
+// GetUser handles GET /users/{id}
+func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	w.Header().Set("Deprecation", "@1767225600")
+	w.Header().Set("Sunset", "Thu, 31 Dec 2026 00:00:00 GMT")
+	opts := &GetUserServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetUserPath{}
+	pathParamIDStr := r.PathValue("id")
+	pathParams.ID = pathParamIDStr
+	opts.PathParams = pathParams
+
+	// Call business logic
+	resp, err := a.svc.GetUser(ctx, opts)
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// GetAccount handles GET /accounts/{id}
+func (a *HTTPAdapter) GetAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := &GetAccountServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetAccountPath{}
+	pathParamIDStr := r.PathValue("id")
+	pathParams.ID = pathParamIDStr
+	opts.PathParams = pathParams
+	// Parse query parameters
+	queryParams := &GetAccountQuery{}
+	query := r.URL.Query()
+	if queryParamFieldsStr := query.Get("fields"); queryParamFieldsStr != "" {
+		queryParamFields := queryParamFieldsStr
+		queryParams.Fields = &queryParamFields
+	}
+	opts.Query = queryParams
+
+	// Call business logic
+	resp, err := a.svc.GetAccount(ctx, opts)
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// RouterOption is a function that configures the router.
+type RouterOption func(*routerConfig)
+
+type routerConfig struct {
+	middlewares []func(http.Handler) http.Handler
+	errHandler  OapiErrorHandler
+	codecs      *runtime.CodecRegistry
+}
+
+// WithMiddleware adds middleware to the router.
+func WithMiddleware(mw func(http.Handler) http.Handler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.middlewares = append(cfg.middlewares, mw)
+	}
+}
+
+// WithErrorHandler sets a custom error handler for the router.
+// If not set, OapiDefaultErrorHandler is used.
+func WithErrorHandler(h OapiErrorHandler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.errHandler = h
+	}
+}
+
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If not set, runtime.DefaultCodecs is used.
+func WithCodecs(codecs *runtime.CodecRegistry) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.codecs = codecs
+	}
+}
+
+// NewRouter creates a new http.ServeMux with the given service implementation.
+func NewRouter(svc ServiceInterface, opts ...RouterOption) *http.ServeMux {
+	cfg := &routerConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	adapter := NewHTTPAdapter(svc, cfg.errHandler).WithCodecs(cfg.codecs)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/{id}", applyMiddleware(http.HandlerFunc(adapter.GetUser), cfg.middlewares...))
+	mux.HandleFunc("GET /accounts/{id}", applyMiddleware(http.HandlerFunc(adapter.GetAccount), cfg.middlewares...))
+
+	return mux
+}
+
+// applyMiddleware wraps a handler with the given middleware chain.
+func applyMiddleware(h http.Handler, middlewares ...func(http.Handler) http.Handler) http.HandlerFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h.ServeHTTP
+}
+
+type GetUserPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetUserPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type GetAccountPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetAccountPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type GetAccountQuery struct {
+	// Deprecated: All fields are always returned.
+	Fields *string `json:"fields,omitempty"`
+}
+
+// GetUserResponseData wraps the success response with optional headers and status override.
+type GetUserResponseData struct {
+	Body    *GetUserResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetUserResponseData creates a new GetUserResponseData with the given body.
+func NewGetUserResponseData(body *GetUserResponse) *GetUserResponseData {
+	return &GetUserResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetUserResponseData) WithHeaders(h http.Header) *GetUserResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetUserResponseData) WithStatus(code int) *GetUserResponseData {
+	r.Status = code
+	return r
+}
+
+// GetAccountResponseData wraps the success response with optional headers and status override.
+type GetAccountResponseData struct {
+	Body    *GetAccountResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetAccountResponseData creates a new GetAccountResponseData with the given body.
+func NewGetAccountResponseData(body *GetAccountResponse) *GetAccountResponseData {
+	return &GetAccountResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetAccountResponseData) WithHeaders(h http.Header) *GetAccountResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetAccountResponseData) WithStatus(code int) *GetAccountResponseData {
+	r.Status = code
+	return r
+}
+
+type GetUserResponse = Account
+
+type GetAccountResponse = Account
+
+// GetUserServiceRequestOptions holds all parameters for the GetUser operation.
+type GetUserServiceRequestOptions struct {
+	PathParams *GetUserPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetUserServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetAccountServiceRequestOptions holds all parameters for the GetAccount operation.
+type GetAccountServiceRequestOptions struct {
+	PathParams *GetAccountPath
+	Query      *GetAccountQuery
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetAccountServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+
+	if o.Query != nil {
+		if v, ok := any(o.Query).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Query", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+type Account struct {
+	ID string `json:"id" validate:"required"`
+	// Deprecated: Use id instead.
+	LegacyID *string `json:"legacyId,omitempty"`
+}
+
+func (a Account) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(a))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,90 @@
+package deprecation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// httpClientAdapter wraps http.Client to implement runtime.HttpRequestDoer
+type httpClientAdapter struct {
+	client *http.Client
+}
+
+func (a *httpClientAdapter) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return a.client.Do(req.WithContext(ctx))
+}
+
+func newTestClient(t *testing.T, observed *[]runtime.Deprecation) (*Client, *httptest.Server) {
+	t.Helper()
+	server := httptest.NewServer(NewRouter(NewService()))
+	t.Cleanup(server.Close)
+
+	client, err := NewDefaultClient(server.URL,
+		runtime.WithHTTPClient(&httpClientAdapter{client: server.Client()}),
+		runtime.WithDeprecationObserver(func(_ context.Context, d runtime.Deprecation) {
+			*observed = append(*observed, d)
+		}),
+	)
+	require.NoError(t, err)
+	return client, server
+}
+
+func TestDeprecatedOperation(t *testing.T) {
+	var observed []runtime.Deprecation
+	client, _ := newTestClient(t, &observed)
+
+	_, err := client.GetUser(context.Background(), &GetUserRequestOptions{
+		PathParams: &GetUserPath{ID: "u1"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []runtime.Deprecation{{
+		OperationID: "GetUser",
+		Method:      http.MethodGet,
+		Path:        "/users/{id}",
+		Sunset:      "Thu, 31 Dec 2026 00:00:00 GMT",
+	}}, observed)
+}
+
+func TestDeprecatedParameter(t *testing.T) {
+	var observed []runtime.Deprecation
+	client, _ := newTestClient(t, &observed)
+
+	_, err := client.GetAccount(context.Background(), &GetAccountRequestOptions{
+		PathParams: &GetAccountPath{ID: "a1"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, observed)
+
+	fields := "id"
+	_, err = client.GetAccount(context.Background(), &GetAccountRequestOptions{
+		PathParams: &GetAccountPath{ID: "a1"},
+		Query:      &GetAccountQuery{Fields: &fields},
+	})
+	require.NoError(t, err)
+	require.Len(t, observed, 1)
+	assert.Equal(t, "fields", observed[0].Parameter)
+	assert.Equal(t, "query", observed[0].In)
+}
+
+func TestDeprecationHeaders(t *testing.T) {
+	_, server := newTestClient(t, new([]runtime.Deprecation))
+
+	resp, err := http.Get(server.URL + "/users/u1")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, "@1767225600", resp.Header.Get("Deprecation"))
+	assert.Equal(t, "Thu, 31 Dec 2026 00:00:00 GMT", resp.Header.Get("Sunset"))
+
+	resp, err = http.Get(server.URL + "/accounts/a1")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Empty(t, resp.Header.Get("Deprecation"))
+	assert.Empty(t, resp.Header.Get("Sunset"))
+}
@@ -0,0 +1,35 @@
+// Package deprecation This file is generated ONCE as a starting point and will NOT be overwritten.
+// Modify it freely to add your business logic.
+// To regenerate, delete this file or set generate.handler.output.overwrite: true in config.
+package deprecation
+
+import (
+	"context"
+)
+
+// Service implements the ServiceInterface.
+// Add your dependencies here (database, clients, etc.)
+type Service struct {
+}
+
+// NewService creates a new Service.
+func NewService() *Service {
+	return &Service{}
+}
+
+// Ensure Service implements ServiceInterface.
+var _ ServiceInterface = (*Service)(nil)
+
+// GetUser handles GET /users/{id}
+// Get a user.
+func (s *Service) GetUser(ctx context.Context, opts *GetUserServiceRequestOptions) (*GetUserResponseData, error) {
+	// TODO: Implement your business logic here
+	return NewGetUserResponseData(new(GetUserResponse)), nil
+}
+
+// GetAccount handles GET /accounts/{id}
+// Get an account.
+func (s *Service) GetAccount(ctx context.Context, opts *GetAccountServiceRequestOptions) (*GetAccountResponseData, error) {
+	// TODO: Implement your business logic here
+	return NewGetAccountResponseData(new(GetAccountResponse)), nil
+}
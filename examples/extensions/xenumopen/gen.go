@@ -0,0 +1,171 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package xenumopen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type PaymentStatus string
+
+const (
+	// Pending The payment has been authorized but not captured yet.
+	Pending PaymentStatus = "pending"
+	// Refunded The payment has been returned to the customer.
+	Refunded PaymentStatus = "refunded"
+	// Settled The funds have been transferred.
+	Settled PaymentStatus = "settled"
+)
+
+// IsKnown returns true if the value is one of the defined PaymentStatus values.
+func (p PaymentStatus) IsKnown() bool {
+	switch p {
+	case Pending, Refunded, Settled:
+		return true
+	default:
+		return false
+	}
+}
+
+// Values returns all defined PaymentStatus values.
+func (PaymentStatus) Values() []PaymentStatus {
+	return []PaymentStatus{
+		Pending,
+		Refunded,
+		Settled,
+	}
+}
+
+// ParsePaymentStatus converts a string to PaymentStatus.
+// Unknown values are returned together with an error, so they can still be used.
+func ParsePaymentStatus(value string) (PaymentStatus, error) {
+	p := PaymentStatus(value)
+	if !p.IsKnown() {
+		return p, fmt.Errorf("unknown PaymentStatus value: %q", value)
+	}
+	return p, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PaymentStatus) MarshalText() ([]byte, error) {
+	return []byte(p), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are accepted.
+func (p *PaymentStatus) UnmarshalText(data []byte) error {
+	*p = PaymentStatus(data)
+	return nil
+}
+
+type PaymentPriority int
+
+const (
+	N1 PaymentPriority = 1
+	N2 PaymentPriority = 2
+	N3 PaymentPriority = 3
+)
+
+// IsKnown returns true if the value is one of the defined PaymentPriority values.
+func (p PaymentPriority) IsKnown() bool {
+	switch p {
+	case N1, N2, N3:
+		return true
+	default:
+		return false
+	}
+}
+
+// Values returns all defined PaymentPriority values.
+func (PaymentPriority) Values() []PaymentPriority {
+	return []PaymentPriority{
+		N1,
+		N2,
+		N3,
+	}
+}
+
+// ParsePaymentPriority converts a string to PaymentPriority.
+// Unknown values are returned together with an error, so they can still be used.
+func ParsePaymentPriority(value string) (PaymentPriority, error) {
+	var p PaymentPriority
+	parsed, err := strconv.ParseInt(value, 10, 0)
+	if err != nil {
+		return p, fmt.Errorf("invalid PaymentPriority value: %q: %w", value, err)
+	}
+	p = PaymentPriority(parsed)
+	if !p.IsKnown() {
+		return p, fmt.Errorf("unknown PaymentPriority value: %q", value)
+	}
+	return p, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PaymentPriority) MarshalText() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(p), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are accepted.
+func (p *PaymentPriority) UnmarshalText(data []byte) error {
+	parsed, err := strconv.ParseInt(string(data), 10, 0)
+	if err != nil {
+		return fmt.Errorf("invalid PaymentPriority value: %q: %w", data, err)
+	}
+	*p = PaymentPriority(parsed)
+	return nil
+}
+
+// MarshalJSON encodes PaymentPriority as a JSON number, instead of the string of MarshalText.
+func (p PaymentPriority) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(p))
+}
+
+// UnmarshalJSON decodes PaymentPriority from a JSON number. Unknown values are accepted.
+func (p *PaymentPriority) UnmarshalJSON(data []byte) error {
+	var value int
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*p = PaymentPriority(value)
+	return nil
+}
+
+type Payment struct {
+	ID       string           `json:"id" validate:"required"`
+	Status   PaymentStatus    `json:"status" validate:"required"`
+	Priority *PaymentPriority `json:"priority,omitempty"`
+}
+
+func (p Payment) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(p.ID, "required"); err != nil {
+		errors = errors.Append("ID", err)
+	}
+	if v, ok := any(p.Status).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Status", err)
+		}
+	}
+	if p.Priority != nil {
+		if v, ok := any(p.Priority).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Priority", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,98 @@
+package xenumopen
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayment_UnknownStatus(t *testing.T) {
+	var payment Payment
+	err := json.Unmarshal([]byte(`{"id":"p1","status":"disputed"}`), &payment)
+	require.NoError(t, err)
+
+	assert.Equal(t, PaymentStatus("disputed"), payment.Status)
+	assert.False(t, payment.Status.IsKnown())
+	assert.NoError(t, payment.Validate())
+
+	data, err := json.Marshal(payment)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"p1","status":"disputed"}`, string(data))
+}
+
+func TestPaymentStatus_IsKnown(t *testing.T) {
+	for _, status := range PaymentStatus("").Values() {
+		assert.True(t, status.IsKnown())
+	}
+	assert.Equal(t, []PaymentStatus{Pending, Refunded, Settled}, Pending.Values())
+}
+
+func TestParsePaymentStatus(t *testing.T) {
+	status, err := ParsePaymentStatus("settled")
+	require.NoError(t, err)
+	assert.Equal(t, Settled, status)
+
+	status, err = ParsePaymentStatus("disputed")
+	assert.EqualError(t, err, `unknown PaymentStatus value: "disputed"`)
+	assert.Equal(t, PaymentStatus("disputed"), status)
+}
+
+func TestPaymentStatus_Text(t *testing.T) {
+	data, err := Refunded.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "refunded", string(data))
+
+	var status PaymentStatus
+	require.NoError(t, status.UnmarshalText([]byte("chargeback")))
+	assert.Equal(t, PaymentStatus("chargeback"), status)
+
+	statuses := map[PaymentStatus]int{Pending: 1}
+	data, err = json.Marshal(statuses)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"pending":1}`, string(data))
+}
+
+func TestPaymentPriority_Unknown(t *testing.T) {
+	var payment Payment
+	err := json.Unmarshal([]byte(`{"id":"p1","status":"settled","priority":7}`), &payment)
+	require.NoError(t, err)
+
+	require.NotNil(t, payment.Priority)
+	assert.Equal(t, PaymentPriority(7), *payment.Priority)
+	assert.False(t, payment.Priority.IsKnown())
+
+	data, err := json.Marshal(payment)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"p1","status":"settled","priority":7}`, string(data))
+}
+
+func TestParsePaymentPriority(t *testing.T) {
+	priority, err := ParsePaymentPriority("2")
+	require.NoError(t, err)
+	assert.Equal(t, N2, priority)
+
+	priority, err = ParsePaymentPriority("7")
+	assert.EqualError(t, err, `unknown PaymentPriority value: "7"`)
+	assert.Equal(t, PaymentPriority(7), priority)
+
+	_, err = ParsePaymentPriority("high")
+	assert.ErrorContains(t, err, `invalid PaymentPriority value: "high"`)
+}
+
+func TestPaymentPriority_Text(t *testing.T) {
+	data, err := N3.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "3", string(data))
+
+	var priority PaymentPriority
+	require.NoError(t, priority.UnmarshalText([]byte("9")))
+	assert.Equal(t, PaymentPriority(9), priority)
+	assert.Error(t, priority.UnmarshalText([]byte("high")))
+
+	priorities := map[PaymentPriority]string{N1: "low"}
+	data, err = json.Marshal(priorities)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"1":"low"}`, string(data))
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
@@ -22,7 +23,9 @@ func NewCustomClientName(apiClient runtime.APIClient) *CustomClientName {
 }
 
 // NewDefaultCustomClientName creates a new instance of the CustomClientName client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
 func NewDefaultCustomClientName(baseURL string, opts ...runtime.APIClientOption) (*CustomClientName, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
 	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating API client: %w", err)
@@ -37,11 +40,19 @@ type CustomClientNameInterface interface {
 
 func (c *CustomClientName) CreateClient(ctx context.Context, options *CreateClientRequestOptions, reqEditors ...runtime.RequestEditorFn) (*CreateClientResponse, error) {
 	var err error
+	if err = runtime.ValidateRequest(c.apiClient, "CreateClient", options); err != nil {
+		return nil, err
+	}
 	reqParams := runtime.RequestOptionsParameters{
 		RequestURL:  c.apiClient.GetBaseURL() + "/clients",
 		Method:      "POST",
 		Options:     options,
 		ContentType: "application/json",
+		Operation: &runtime.OperationInfo{
+			ID:     "CreateClient",
+			Method: "POST",
+			Path:   "/clients",
+		},
 	}
 
 	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
@@ -53,12 +64,15 @@ func (c *CustomClientName) CreateClient(ctx context.Context, options *CreateClie
 		bodyBytes := resp.Content
 		if resp.StatusCode != 200 {
 			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
-				runtime.WithStatusCode(resp.StatusCode))
+				runtime.WithResponse(c.apiClient, resp))
 		}
 		target := new(CreateClientResponse)
 		if err = json.Unmarshal(bodyBytes, target); err != nil {
-			err = fmt.Errorf("error decoding response: %w", err)
-			return nil, err
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "CreateClient", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
 		}
 		return target, nil
 	}
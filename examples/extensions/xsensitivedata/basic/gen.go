@@ -3,12 +3,124 @@
 package xsensitivedata
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
 )
 
+// Client is the client for the API implementing the Client interface.
+type Client struct {
+	apiClient runtime.APIClient
+}
+
+// NewClient creates a new instance of the Client client.
+func NewClient(apiClient runtime.APIClient) *Client {
+	return &Client{apiClient: apiClient}
+}
+
+// NewDefaultClient creates a new instance of the Client client with default api client.
+// Each call times out after 3s, unless its operation sets x-timeout or opts set another timeout.
+// Errors mask the properties marked with x-sensitive-data.
+func NewDefaultClient(baseURL string, opts ...runtime.APIClientOption) (*Client, error) {
+	opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)
+	opts = append([]runtime.APIClientOption{runtime.WithErrorSensitiveData(sensitiveData)}, opts...)
+	apiClient, err := runtime.NewAPIClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API client: %w", err)
+	}
+	return &Client{apiClient: apiClient}, nil
+}
+
+// ClientInterface is the interface for the API client.
+type ClientInterface interface {
+	GetUsers(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*GetUsersResponse, error)
+}
+
+func (c *Client) GetUsers(ctx context.Context, reqEditors ...runtime.RequestEditorFn) (*GetUsersResponse, error) {
+	var err error
+	reqParams := runtime.RequestOptionsParameters{
+		RequestURL: c.apiClient.GetBaseURL() + "/users",
+		Method:     "GET",
+		Operation: &runtime.OperationInfo{
+			ID:     "GetUsers",
+			Method: "GET",
+			Path:   "/users",
+		},
+	}
+
+	req, err := c.apiClient.CreateRequest(ctx, reqParams, reqEditors...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	responseParser := func(ctx context.Context, resp *runtime.Response) (*GetUsersResponse, error) {
+		bodyBytes := resp.Content
+		if resp.StatusCode != 200 {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("unexpected status code: %d", resp.StatusCode),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		target := new(GetUsersResponse)
+		if err = json.Unmarshal(bodyBytes, target); err != nil {
+			return nil, runtime.NewClientAPIError(fmt.Errorf("error decoding response: %w", err),
+				runtime.WithResponse(c.apiClient, resp))
+		}
+		if err = runtime.ValidateResponse(c.apiClient, "GetUsers", resp.StatusCode, target); err != nil {
+			return nil, runtime.NewClientAPIError(err, runtime.WithResponse(c.apiClient, resp))
+		}
+		return target, nil
+	}
+
+	resp, err := c.apiClient.ExecuteRequest(ctx, req, "/users")
+	if err != nil {
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return responseParser(ctx, resp)
+}
+
+var _ ClientInterface = (*Client)(nil)
+
+// CassetteSensitiveData returns the cassette option masking the JSON properties marked with x-sensitive-data.
+func CassetteSensitiveData() runtime.CassetteOption {
+	return runtime.WithCassetteSensitiveFields(sensitiveData)
+}
+
+// sensitiveData maps the JSON properties marked with x-sensitive-data to their masking rules.
+var sensitiveData = map[string]runtime.SensitiveDataConfig{
+	"apiKey": {
+		Type:       runtime.MaskTypeHash,
+		Pattern:    "",
+		Algorithm:  "sha256",
+		KeepPrefix: 0,
+		KeepSuffix: 0,
+	},
+	"creditCard": {
+		Type:       runtime.MaskTypePartial,
+		Pattern:    "",
+		Algorithm:  "",
+		KeepPrefix: 0,
+		KeepSuffix: 4,
+	},
+	"email": {
+		Type:       runtime.MaskTypeFull,
+		Pattern:    "",
+		Algorithm:  "",
+		KeepPrefix: 0,
+		KeepSuffix: 0,
+	},
+	"ssn": {
+		Type:       runtime.MaskTypeRegex,
+		Pattern:    "\\d{3}-\\d{2}-\\d{4}",
+		Algorithm:  "",
+		KeepPrefix: 0,
+		KeepSuffix: 0,
+	},
+}
+
 type GetUsersResponse []User
 
 type User struct {
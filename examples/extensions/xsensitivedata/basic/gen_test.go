@@ -1,11 +1,16 @@
 package xsensitivedata
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
 )
 
 func TestUserMarshalJSON_RawData(t *testing.T) {
@@ -101,3 +106,25 @@ func TestUserUnmarshalJSON(t *testing.T) {
 	require.NotNil(t, user.Email)
 	assert.Equal(t, "user@example.com", *user.Email)
 }
+
+func TestCassetteSensitiveData(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":1,"username":"testuser","email":"user@example.com","creditCard":"1234-5678-9012-3456"}]`))
+	})
+	path := filepath.Join(t.TempDir(), "users.yaml")
+	doer := runtime.NewRecordingDoer(runtime.NewHandlerDoer(handler), path, CassetteSensitiveData())
+
+	client, err := NewDefaultClient("http://sandbox", runtime.WithHTTPClient(doer))
+	require.NoError(t, err)
+	users, err := client.GetUsers(context.Background())
+	require.NoError(t, err)
+	require.Len(t, *users, 1)
+	assert.Equal(t, "user@example.com", *(*users)[0].Email)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "user@example.com")
+	assert.NotContains(t, string(data), "1234-5678-9012-3456")
+	assert.Contains(t, string(data), "3456")
+}
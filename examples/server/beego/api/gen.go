@@ -105,6 +105,7 @@ type ServiceInterface interface {
 type HTTPAdapter struct {
 	svc        ServiceInterface
 	errHandler OapiErrorHandler
+	codecs     *runtime.CodecRegistry
 }
 
 // NewHTTPAdapter creates a new HTTPAdapter wrapping the given service.
@@ -116,6 +117,13 @@ func NewHTTPAdapter(svc ServiceInterface, errHandler OapiErrorHandler) *HTTPAdap
 	return &HTTPAdapter{svc: svc, errHandler: errHandler}
 }
 
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If codecs is nil, runtime.DefaultCodecs is used.
+func (a *HTTPAdapter) WithCodecs(codecs *runtime.CodecRegistry) *HTTPAdapter {
+	a.codecs = codecs
+	return a
+}
+
 // HealthCheck handles GET /health
 func (a *HTTPAdapter) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -211,6 +219,10 @@ func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
 
 	// Parse request body
 	defer r.Body.Close()
+	if err := runtime.DecompressRequestBody(r, 32<<20); err != nil {
+		a.errHandler.HandleError(w, r, runtime.DecompressErrorStatus(err, 400), NewCreateUserErrorResponse(err.Error()))
+		return
+	}
 	var body CreateUserBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		a.errHandler.HandleError(w, r, 400, NewCreateUserErrorResponse(err.Error()))
@@ -334,6 +346,7 @@ type RouterOption func(*routerConfig)
 type routerConfig struct {
 	middlewares []beego.MiddleWare
 	errHandler  OapiErrorHandler
+	codecs      *runtime.CodecRegistry
 }
 
 // WithMiddleware adds middleware to the router.
@@ -351,6 +364,14 @@ func WithErrorHandler(h OapiErrorHandler) RouterOption {
 	}
 }
 
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If not set, runtime.DefaultCodecs is used.
+func WithCodecs(codecs *runtime.CodecRegistry) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.codecs = codecs
+	}
+}
+
 // beegoHandler wraps an http.HandlerFunc for Beego with path param injection.
 func beegoHandler(h http.HandlerFunc, pathParams ...string) beego.HandleFunc {
 	return func(ctx *beecontext.Context) {
@@ -370,7 +391,7 @@ func RegisterRoutes(router *beego.ControllerRegister, svc ServiceInterface, opts
 		opt(cfg)
 	}
 
-	httpAdapter := NewHTTPAdapter(svc, cfg.errHandler)
+	httpAdapter := NewHTTPAdapter(svc, cfg.errHandler).WithCodecs(cfg.codecs)
 	router.Get("/health", beegoHandler(httpAdapter.HealthCheck))
 	router.Get("/users", beegoHandler(httpAdapter.ListUsers))
 	router.Post("/users", beegoHandler(httpAdapter.CreateUser))
@@ -104,6 +104,7 @@ type ServiceInterface interface {
 type HTTPAdapter struct {
 	svc        ServiceInterface
 	errHandler OapiErrorHandler
+	codecs     *runtime.CodecRegistry
 }
 
 // NewHTTPAdapter creates a new HTTPAdapter wrapping the given service.
@@ -115,6 +116,13 @@ func NewHTTPAdapter(svc ServiceInterface, errHandler OapiErrorHandler) *HTTPAdap
 	return &HTTPAdapter{svc: svc, errHandler: errHandler}
 }
 
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If codecs is nil, runtime.DefaultCodecs is used.
+func (a *HTTPAdapter) WithCodecs(codecs *runtime.CodecRegistry) *HTTPAdapter {
+	a.codecs = codecs
+	return a
+}
+
 // HealthCheck handles GET /health
 func (a *HTTPAdapter) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -210,6 +218,10 @@ func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
 
 	// Parse request body
 	defer r.Body.Close()
+	if err := runtime.DecompressRequestBody(r, 32<<20); err != nil {
+		a.errHandler.HandleError(w, r, runtime.DecompressErrorStatus(err, 400), NewCreateUserErrorResponse(err.Error()))
+		return
+	}
 	var body CreateUserBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		a.errHandler.HandleError(w, r, 400, NewCreateUserErrorResponse(err.Error()))
@@ -333,6 +345,7 @@ type RouterOption func(*routerConfig)
 type routerConfig struct {
 	middlewares []func(http.Handler) http.Handler
 	errHandler  OapiErrorHandler
+	codecs      *runtime.CodecRegistry
 }
 
 // WithMiddleware adds middleware to the router.
@@ -350,6 +363,14 @@ func WithErrorHandler(h OapiErrorHandler) RouterOption {
 	}
 }
 
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If not set, runtime.DefaultCodecs is used.
+func WithCodecs(codecs *runtime.CodecRegistry) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.codecs = codecs
+	}
+}
+
 // NewRouter creates a new chi.Router with the given service implementation.
 func NewRouter(svc ServiceInterface, opts ...RouterOption) chi.Router {
 	cfg := &routerConfig{}
@@ -357,7 +378,7 @@ func NewRouter(svc ServiceInterface, opts ...RouterOption) chi.Router {
 		opt(cfg)
 	}
 
-	adapter := NewHTTPAdapter(svc, cfg.errHandler)
+	adapter := NewHTTPAdapter(svc, cfg.errHandler).WithCodecs(cfg.codecs)
 
 	r := chi.NewRouter()
 	for _, mw := range cfg.middlewares {
@@ -29,6 +29,7 @@ type ServiceInterface interface {
 type HTTPAdapter struct {
 	svc        ServiceInterface
 	errHandler OapiErrorHandler
+	codecs     *runtime.CodecRegistry
 }
 
 // NewHTTPAdapter creates a new HTTPAdapter wrapping the given service.
@@ -40,6 +41,13 @@ func NewHTTPAdapter(svc ServiceInterface, errHandler OapiErrorHandler) *HTTPAdap
 	return &HTTPAdapter{svc: svc, errHandler: errHandler}
 }
 
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If codecs is nil, runtime.DefaultCodecs is used.
+func (a *HTTPAdapter) WithCodecs(codecs *runtime.CodecRegistry) *HTTPAdapter {
+	a.codecs = codecs
+	return a
+}
+
 // HealthCheck handles GET /health
 func (a *HTTPAdapter) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -135,6 +143,14 @@ func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
 
 	// Parse request body
 	defer r.Body.Close()
+	if err := runtime.DecompressRequestBody(r, 32<<20); err != nil {
+		a.errHandler.HandleError(w, r, runtime.DecompressErrorStatus(err, http.StatusBadRequest), OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreateUser",
+			Message:     err.Error(),
+		})
+		return
+	}
 	var body CreateUserBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
 )
 
 // RouterOption is a function that configures the router.
@@ -13,6 +14,7 @@ type RouterOption func(*routerConfig)
 type routerConfig struct {
 	middlewares []func(http.Handler) http.Handler
 	errHandler  OapiErrorHandler
+	codecs      *runtime.CodecRegistry
 }
 
 // WithMiddleware adds middleware to the router.
@@ -30,6 +32,14 @@ func WithErrorHandler(h OapiErrorHandler) RouterOption {
 	}
 }
 
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If not set, runtime.DefaultCodecs is used.
+func WithCodecs(codecs *runtime.CodecRegistry) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.codecs = codecs
+	}
+}
+
 // NewRouter creates a new chi.Router with the given service implementation.
 func NewRouter(svc ServiceInterface, opts ...RouterOption) chi.Router {
 	cfg := &routerConfig{}
@@ -37,7 +47,7 @@ func NewRouter(svc ServiceInterface, opts ...RouterOption) chi.Router {
 		opt(cfg)
 	}
 
-	adapter := NewHTTPAdapter(svc, cfg.errHandler)
+	adapter := NewHTTPAdapter(svc, cfg.errHandler).WithCodecs(cfg.codecs)
 
 	r := chi.NewRouter()
 	for _, mw := range cfg.middlewares {
@@ -0,0 +1,28 @@
+// Package api This file is generated ONCE as a starting point and will NOT be overwritten.
+// Modify it freely to add your business logic.
+// To regenerate, delete this file or set generate.handler.output.overwrite: true in config.
+package api
+
+import (
+	"context"
+)
+
+// DefaultService implements the DefaultServiceInterface.
+// Add your dependencies here (database, clients, etc.)
+type DefaultService struct {
+}
+
+// NewDefaultService creates a new DefaultService.
+func NewDefaultService() *DefaultService {
+	return &DefaultService{}
+}
+
+// Ensure DefaultService implements DefaultServiceInterface.
+var _ DefaultServiceInterface = (*DefaultService)(nil)
+
+// HealthCheck handles GET /health
+// Health check endpoint
+func (d *DefaultService) HealthCheck(ctx context.Context) (*HealthCheckResponseData, error) {
+	// TODO: Implement your business logic here
+	return NewHealthCheckResponseData(new(struct{})), nil
+}
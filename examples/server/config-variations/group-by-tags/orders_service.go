@@ -0,0 +1,35 @@
+// Package api This file is generated ONCE as a starting point and will NOT be overwritten.
+// Modify it freely to add your business logic.
+// To regenerate, delete this file or set generate.handler.output.overwrite: true in config.
+package api
+
+import (
+	"context"
+)
+
+// OrdersService implements the OrdersServiceInterface.
+// Add your dependencies here (database, clients, etc.)
+type OrdersService struct {
+}
+
+// NewOrdersService creates a new OrdersService.
+func NewOrdersService() *OrdersService {
+	return &OrdersService{}
+}
+
+// Ensure OrdersService implements OrdersServiceInterface.
+var _ OrdersServiceInterface = (*OrdersService)(nil)
+
+// ListOrders handles GET /orders
+// List all orders
+func (o *OrdersService) ListOrders(ctx context.Context) (*ListOrdersResponseData, error) {
+	// TODO: Implement your business logic here
+	return NewListOrdersResponseData(new(ListOrdersResponse)), nil
+}
+
+// CreateOrder handles POST /orders
+// Create an order
+func (o *OrdersService) CreateOrder(ctx context.Context, opts *CreateOrderServiceRequestOptions) (*CreateOrderResponseData, error) {
+	// TODO: Implement your business logic here
+	return NewCreateOrderResponseData(new(CreateOrderResponse)), nil
+}
@@ -0,0 +1,14 @@
+// Package api This file is generated ONCE as a starting point and will NOT be overwritten.
+// Modify it freely to add your business logic.
+// To regenerate, delete this file or set generate.handler.output.overwrite: true in config.
+package api
+
+// NewService creates the service of each group.
+// The operations of a nil service are answered with 501 Not Implemented.
+func NewService() *Services {
+	return &Services{
+		Default: NewDefaultService(),
+		Orders:  NewOrdersService(),
+		Users:   NewUsersService(),
+	}
+}
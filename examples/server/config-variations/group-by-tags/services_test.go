@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ordersService implements only ListOrders, the other orders operations are unimplemented.
+type ordersService struct {
+	UnimplementedOrdersService
+}
+
+func (s *ordersService) ListOrders(_ context.Context) (*ListOrdersResponseData, error) {
+	return NewListOrdersResponseData(&ListOrdersResponse{{ID: "o1"}}), nil
+}
+
+func TestServices(t *testing.T) {
+	router := NewRouter(&Services{Orders: &ordersService{}})
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("implemented operation", func(t *testing.T) {
+		rr := serve(http.MethodGet, "/orders", "")
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var orders []Order
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &orders))
+		assert.Equal(t, []Order{{ID: "o1"}}, orders)
+	})
+
+	t.Run("unimplemented operation", func(t *testing.T) {
+		rr := serve(http.MethodPost, "/orders", `{"id":"o2"}`)
+		assert.Equal(t, http.StatusNotImplemented, rr.Code)
+	})
+
+	t.Run("nil service", func(t *testing.T) {
+		rr := serve(http.MethodGet, "/users/u1", "")
+		require.Equal(t, http.StatusNotImplemented, rr.Code)
+
+		var resp OapiErrorResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Equal(t, "GetUser", resp.OperationID)
+		assert.Equal(t, "GetUser is not implemented", resp.Error)
+
+		assert.Equal(t, http.StatusNotImplemented, serve(http.MethodGet, "/health", "").Code)
+	})
+}
+
+func TestNewService(t *testing.T) {
+	router := NewRouter(NewService())
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/health", nil))
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+}
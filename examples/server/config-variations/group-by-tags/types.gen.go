@@ -0,0 +1,640 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// OapiErrorKind represents the type of error that occurred during request processing.
+type OapiErrorKind int
+
+const (
+	// OapiErrorKindParse indicates a parameter parsing error (invalid path/query/header parameter).
+	OapiErrorKindParse OapiErrorKind = iota
+
+	// OapiErrorKindDecode indicates a request body decoding error (invalid JSON, form data, etc.).
+	OapiErrorKindDecode
+
+	// OapiErrorKindValidation indicates a request validation error (failed schema validation).
+	OapiErrorKindValidation
+
+	// OapiErrorKindService indicates a service/business logic error returned by the service implementation.
+	OapiErrorKindService
+
+	// OapiErrorKindNotImplemented indicates an operation without a service implementation, answered with 501 Not Implemented.
+	OapiErrorKindNotImplemented
+)
+
+// OapiHandlerError represents an error that occurred during request handling (parse, decode, validation).
+// When no typed error response is configured in the OpenAPI spec, this error type is used.
+// Custom error handlers can type-assert to this type to access error details.
+type OapiHandlerError struct {
+	Kind          OapiErrorKind
+	OperationID   string
+	Message       string
+	ParamName     string
+	ParamLocation string
+}
+
+func (e OapiHandlerError) Error() string {
+	return e.Message
+}
+
+// OapiErrorResponse is the default JSON error response structure used by OapiDefaultErrorHandler.
+type OapiErrorResponse struct {
+	Error         string `json:"error"`
+	OperationID   string `json:"operation_id,omitempty"`
+	ParamName     string `json:"param_name,omitempty"`
+	ParamLocation string `json:"param_location,omitempty"`
+}
+
+// OapiErrorHandler handles errors that occur during request processing.
+// Implement this interface to customize error responses, logging, and metrics.
+type OapiErrorHandler interface {
+	// HandleError writes an error response to w with the given status code.
+	// The err is either an OapiHandlerError (for parse/decode/validation errors)
+	// or a typed error matching the OpenAPI spec's error response schema.
+	HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error)
+}
+
+// OapiDefaultErrorHandler provides the default error handling behavior.
+// It writes JSON error responses. For OapiHandlerError, it uses OapiErrorResponse.
+// For typed errors (from OpenAPI spec), it encodes them directly.
+type OapiDefaultErrorHandler struct{}
+
+// HandleError implements OapiErrorHandler with default JSON error responses.
+func (h *OapiDefaultErrorHandler) HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if handlerErr, ok := err.(OapiHandlerError); ok {
+		_ = json.NewEncoder(w).Encode(OapiErrorResponse{
+			Error:         handlerErr.Message,
+			OperationID:   handlerErr.OperationID,
+			ParamName:     handlerErr.ParamName,
+			ParamLocation: handlerErr.ParamLocation,
+		})
+		return
+	}
+
+	// Typed error from OpenAPI spec - encode directly
+	_ = json.NewEncoder(w).Encode(err)
+}
+
+// ServiceInterface defines the service interface for business logic, one embedded interface per group.
+type ServiceInterface interface {
+	DefaultServiceInterface
+	OrdersServiceInterface
+	UsersServiceInterface
+}
+
+// DefaultServiceInterface defines the service interface for the operations without tags.
+type DefaultServiceInterface interface {
+	// HealthCheck Health check endpoint
+	HealthCheck(ctx context.Context) (*HealthCheckResponseData, error)
+}
+
+// UnimplementedDefaultService answers all operations without tags with 501 Not Implemented.
+// Embed it in an implementation of DefaultServiceInterface to implement the operations one at a time.
+type UnimplementedDefaultService struct{}
+
+// HealthCheck returns an OapiErrorKindNotImplemented error.
+func (UnimplementedDefaultService) HealthCheck(ctx context.Context) (*HealthCheckResponseData, error) {
+	return nil, OapiHandlerError{
+		Kind:        OapiErrorKindNotImplemented,
+		OperationID: "HealthCheck",
+		Message:     "HealthCheck is not implemented",
+	}
+}
+
+// OrdersServiceInterface defines the service interface for the operations tagged orders.
+type OrdersServiceInterface interface {
+	// ListOrders List all orders
+	ListOrders(ctx context.Context) (*ListOrdersResponseData, error)
+	// CreateOrder Create an order
+	CreateOrder(ctx context.Context, opts *CreateOrderServiceRequestOptions) (*CreateOrderResponseData, error)
+}
+
+// UnimplementedOrdersService answers all operations tagged orders with 501 Not Implemented.
+// Embed it in an implementation of OrdersServiceInterface to implement the operations one at a time.
+type UnimplementedOrdersService struct{}
+
+// ListOrders returns an OapiErrorKindNotImplemented error.
+func (UnimplementedOrdersService) ListOrders(ctx context.Context) (*ListOrdersResponseData, error) {
+	return nil, OapiHandlerError{
+		Kind:        OapiErrorKindNotImplemented,
+		OperationID: "ListOrders",
+		Message:     "ListOrders is not implemented",
+	}
+}
+
+// CreateOrder returns an OapiErrorKindNotImplemented error.
+func (UnimplementedOrdersService) CreateOrder(ctx context.Context, opts *CreateOrderServiceRequestOptions) (*CreateOrderResponseData, error) {
+	return nil, OapiHandlerError{
+		Kind:        OapiErrorKindNotImplemented,
+		OperationID: "CreateOrder",
+		Message:     "CreateOrder is not implemented",
+	}
+}
+
+// UsersServiceInterface defines the service interface for the operations tagged users.
+type UsersServiceInterface interface {
+	// GetUser Get a user
+	GetUser(ctx context.Context, opts *GetUserServiceRequestOptions) (*GetUserResponseData, error)
+}
+
+// UnimplementedUsersService answers all operations tagged users with 501 Not Implemented.
+// Embed it in an implementation of UsersServiceInterface to implement the operations one at a time.
+type UnimplementedUsersService struct{}
+
+// GetUser returns an OapiErrorKindNotImplemented error.
+func (UnimplementedUsersService) GetUser(ctx context.Context, opts *GetUserServiceRequestOptions) (*GetUserResponseData, error) {
+	return nil, OapiHandlerError{
+		Kind:        OapiErrorKindNotImplemented,
+		OperationID: "GetUser",
+		Message:     "GetUser is not implemented",
+	}
+}
+
+// Services implements ServiceInterface with a service per group.
+// The operations of a nil service are answered with 501 Not Implemented.
+type Services struct {
+	Default DefaultServiceInterface
+	Orders  OrdersServiceInterface
+	Users   UsersServiceInterface
+}
+
+var _ ServiceInterface = (*Services)(nil)
+
+// HealthCheck calls HealthCheck of the Default service.
+func (s *Services) HealthCheck(ctx context.Context) (*HealthCheckResponseData, error) {
+	if s.Default == nil {
+		return UnimplementedDefaultService{}.HealthCheck(ctx)
+	}
+	return s.Default.HealthCheck(ctx)
+}
+
+// ListOrders calls ListOrders of the Orders service.
+func (s *Services) ListOrders(ctx context.Context) (*ListOrdersResponseData, error) {
+	if s.Orders == nil {
+		return UnimplementedOrdersService{}.ListOrders(ctx)
+	}
+	return s.Orders.ListOrders(ctx)
+}
+
+// CreateOrder calls CreateOrder of the Orders service.
+func (s *Services) CreateOrder(ctx context.Context, opts *CreateOrderServiceRequestOptions) (*CreateOrderResponseData, error) {
+	if s.Orders == nil {
+		return UnimplementedOrdersService{}.CreateOrder(ctx, opts)
+	}
+	return s.Orders.CreateOrder(ctx, opts)
+}
+
+// GetUser calls GetUser of the Users service.
+func (s *Services) GetUser(ctx context.Context, opts *GetUserServiceRequestOptions) (*GetUserResponseData, error) {
+	if s.Users == nil {
+		return UnimplementedUsersService{}.GetUser(ctx, opts)
+	}
+	return s.Users.GetUser(ctx, opts)
+}
+
+// HTTPAdapter adapts the ServiceInterface to HTTP handlers.
+// This struct is generated and should not be modified.
+type HTTPAdapter struct {
+	svc        ServiceInterface
+	errHandler OapiErrorHandler
+	codecs     *runtime.CodecRegistry
+}
+
+// NewHTTPAdapter creates a new HTTPAdapter wrapping the given service.
+// If errHandler is nil, OapiDefaultErrorHandler is used.
+func NewHTTPAdapter(svc ServiceInterface, errHandler OapiErrorHandler) *HTTPAdapter {
+	if errHandler == nil {
+		errHandler = &OapiDefaultErrorHandler{}
+	}
+	return &HTTPAdapter{svc: svc, errHandler: errHandler}
+}
+
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If codecs is nil, runtime.DefaultCodecs is used.
+func (a *HTTPAdapter) WithCodecs(codecs *runtime.CodecRegistry) *HTTPAdapter {
+	a.codecs = codecs
+	return a
+}
+
+// HealthCheck handles GET /health
+func (a *HTTPAdapter) HealthCheck(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Call business logic
+	resp, err := a.svc.HealthCheck(ctx)
+	if err != nil {
+		code := http.StatusInternalServerError
+		if handlerErr, ok := err.(OapiHandlerError); ok && handlerErr.Kind == OapiErrorKindNotImplemented {
+			code = http.StatusNotImplemented
+		}
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 204
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.WriteHeader(status)
+}
+
+// GetUser handles GET /users/{id}
+func (a *HTTPAdapter) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := &GetUserServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetUserPath{}
+	pathParamIDStr := chi.URLParam(r, "id")
+	pathParams.ID = pathParamIDStr
+	opts.PathParams = pathParams
+
+	// Call business logic
+	resp, err := a.svc.GetUser(ctx, opts)
+	if err != nil {
+		code := http.StatusInternalServerError
+		if handlerErr, ok := err.(OapiHandlerError); ok && handlerErr.Kind == OapiErrorKindNotImplemented {
+			code = http.StatusNotImplemented
+		}
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// ListOrders handles GET /orders
+func (a *HTTPAdapter) ListOrders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Call business logic
+	resp, err := a.svc.ListOrders(ctx)
+	if err != nil {
+		code := http.StatusInternalServerError
+		if handlerErr, ok := err.(OapiHandlerError); ok && handlerErr.Kind == OapiErrorKindNotImplemented {
+			code = http.StatusNotImplemented
+		}
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 200
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// CreateOrder handles POST /orders
+func (a *HTTPAdapter) CreateOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := &CreateOrderServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	defer r.Body.Close()
+	if err := runtime.DecompressRequestBody(r, 32<<20); err != nil {
+		a.errHandler.HandleError(w, r, runtime.DecompressErrorStatus(err, http.StatusBadRequest), OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreateOrder",
+			Message:     err.Error(),
+		})
+		return
+	}
+	var body CreateOrderBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreateOrder",
+			Message:     err.Error(),
+		})
+		return
+	}
+	opts.Body = &body
+
+	// Call business logic
+	resp, err := a.svc.CreateOrder(ctx, opts)
+	if err != nil {
+		code := http.StatusInternalServerError
+		if handlerErr, ok := err.(OapiHandlerError); ok && handlerErr.Kind == OapiErrorKindNotImplemented {
+			code = http.StatusNotImplemented
+		}
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// Apply custom headers from response
+	if resp != nil && resp.Headers != nil {
+		for k, v := range resp.Headers {
+			for _, val := range v {
+				w.Header().Add(k, val)
+			}
+		}
+	}
+
+	// Determine status code
+	status := 201
+	if resp != nil && resp.Status != 0 {
+		status = resp.Status
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if resp != nil && resp.Body != nil {
+		_ = json.NewEncoder(w).Encode(resp.Body)
+	}
+}
+
+// RouterOption is a function that configures the router.
+type RouterOption func(*routerConfig)
+
+type routerConfig struct {
+	middlewares []func(http.Handler) http.Handler
+	errHandler  OapiErrorHandler
+	codecs      *runtime.CodecRegistry
+}
+
+// WithMiddleware adds middleware to the router.
+func WithMiddleware(mw func(http.Handler) http.Handler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.middlewares = append(cfg.middlewares, mw)
+	}
+}
+
+// WithErrorHandler sets a custom error handler for the router.
+// If not set, OapiOapiDefaultErrorHandler is used.
+func WithErrorHandler(h OapiErrorHandler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.errHandler = h
+	}
+}
+
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If not set, runtime.DefaultCodecs is used.
+func WithCodecs(codecs *runtime.CodecRegistry) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.codecs = codecs
+	}
+}
+
+// NewRouter creates a new chi.Router with the given service implementation.
+func NewRouter(svc ServiceInterface, opts ...RouterOption) chi.Router {
+	cfg := &routerConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	adapter := NewHTTPAdapter(svc, cfg.errHandler).WithCodecs(cfg.codecs)
+
+	r := chi.NewRouter()
+	for _, mw := range cfg.middlewares {
+		r.Use(mw)
+	}
+	r.Method("GET", "/health", http.HandlerFunc(adapter.HealthCheck))
+	r.Method("GET", "/users/{id}", http.HandlerFunc(adapter.GetUser))
+	r.Method("GET", "/orders", http.HandlerFunc(adapter.ListOrders))
+	r.Method("POST", "/orders", http.HandlerFunc(adapter.CreateOrder))
+
+	return r
+}
+
+type GetUserPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetUserPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type CreateOrderBody = Order
+
+// HealthCheckResponseData wraps the success response with optional headers and status override.
+type HealthCheckResponseData struct {
+	Body    *struct{}
+	Headers http.Header
+	Status  int // 0 = use default (204)
+}
+
+// NewHealthCheckResponseData creates a new HealthCheckResponseData with the given body.
+func NewHealthCheckResponseData(body *struct{}) *HealthCheckResponseData {
+	return &HealthCheckResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *HealthCheckResponseData) WithHeaders(h http.Header) *HealthCheckResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *HealthCheckResponseData) WithStatus(code int) *HealthCheckResponseData {
+	r.Status = code
+	return r
+}
+
+// GetUserResponseData wraps the success response with optional headers and status override.
+type GetUserResponseData struct {
+	Body    *GetUserResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewGetUserResponseData creates a new GetUserResponseData with the given body.
+func NewGetUserResponseData(body *GetUserResponse) *GetUserResponseData {
+	return &GetUserResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *GetUserResponseData) WithHeaders(h http.Header) *GetUserResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *GetUserResponseData) WithStatus(code int) *GetUserResponseData {
+	r.Status = code
+	return r
+}
+
+// ListOrdersResponseData wraps the success response with optional headers and status override.
+type ListOrdersResponseData struct {
+	Body    *ListOrdersResponse
+	Headers http.Header
+	Status  int // 0 = use default (200)
+}
+
+// NewListOrdersResponseData creates a new ListOrdersResponseData with the given body.
+func NewListOrdersResponseData(body *ListOrdersResponse) *ListOrdersResponseData {
+	return &ListOrdersResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *ListOrdersResponseData) WithHeaders(h http.Header) *ListOrdersResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *ListOrdersResponseData) WithStatus(code int) *ListOrdersResponseData {
+	r.Status = code
+	return r
+}
+
+// CreateOrderResponseData wraps the success response with optional headers and status override.
+type CreateOrderResponseData struct {
+	Body    *CreateOrderResponse
+	Headers http.Header
+	Status  int // 0 = use default (201)
+}
+
+// NewCreateOrderResponseData creates a new CreateOrderResponseData with the given body.
+func NewCreateOrderResponseData(body *CreateOrderResponse) *CreateOrderResponseData {
+	return &CreateOrderResponseData{Body: body}
+}
+
+// WithHeaders sets custom headers on the response.
+func (r *CreateOrderResponseData) WithHeaders(h http.Header) *CreateOrderResponseData {
+	r.Headers = h
+	return r
+}
+
+// WithStatus overrides the default status code.
+func (r *CreateOrderResponseData) WithStatus(code int) *CreateOrderResponseData {
+	r.Status = code
+	return r
+}
+
+type GetUserResponse = User
+
+type ListOrdersResponse []Order
+
+type CreateOrderResponse = Order
+
+// GetUserServiceRequestOptions holds all parameters for the GetUser operation.
+type GetUserServiceRequestOptions struct {
+	PathParams *GetUserPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetUserServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// CreateOrderServiceRequestOptions holds all parameters for the CreateOrder operation.
+type CreateOrderServiceRequestOptions struct {
+	Body *CreateOrderBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *CreateOrderServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+type User struct {
+	ID   string `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func (u User) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(u))
+}
+
+type Order struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (o Order) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(o))
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,28 @@
+// Package api This file is generated ONCE as a starting point and will NOT be overwritten.
+// Modify it freely to add your business logic.
+// To regenerate, delete this file or set generate.handler.output.overwrite: true in config.
+package api
+
+import (
+	"context"
+)
+
+// UsersService implements the UsersServiceInterface.
+// Add your dependencies here (database, clients, etc.)
+type UsersService struct {
+}
+
+// NewUsersService creates a new UsersService.
+func NewUsersService() *UsersService {
+	return &UsersService{}
+}
+
+// Ensure UsersService implements UsersServiceInterface.
+var _ UsersServiceInterface = (*UsersService)(nil)
+
+// GetUser handles GET /users/{id}
+// Get a user
+func (u *UsersService) GetUser(ctx context.Context, opts *GetUserServiceRequestOptions) (*GetUserResponseData, error) {
+	// TODO: Implement your business logic here
+	return NewGetUserResponseData(new(GetUserResponse)), nil
+}
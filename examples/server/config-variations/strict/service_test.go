@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// petStore returns a different documented response per case.
+type petStore struct {
+	pets    map[string]Pet
+	deleted map[string]bool
+}
+
+func (s *petStore) CreatePet(_ context.Context, opts *CreatePetServiceRequestOptions) (CreatePetResponseObject, error) {
+	pet := *opts.Body
+	if _, ok := s.pets[pet.ID]; ok {
+		return CreatePet409Response{Body: &Error{Message: "pet " + pet.ID + " exists"}}, nil
+	}
+	s.pets[pet.ID] = pet
+	return CreatePet201Response{
+		Body:    &pet,
+		Headers: CreatePet201ResponseHeaders{Location: "/pets/" + pet.ID},
+	}, nil
+}
+
+func (s *petStore) GetPet(_ context.Context, opts *GetPetServiceRequestOptions) (GetPetResponseObject, error) {
+	id := opts.PathParams.ID
+	if s.deleted[id] {
+		return GetPet410Response{}, nil
+	}
+	pet, ok := s.pets[id]
+	if !ok {
+		return GetPet404Response{Body: &Error{Message: "pet " + id + " not found"}}, nil
+	}
+	return GetPet200Response{
+		Body:    &pet,
+		Headers: GetPet200ResponseHeaders{XVersion: runtime.Ptr(3)},
+	}, nil
+}
+
+func (s *petStore) DeletePet(_ context.Context, opts *DeletePetServiceRequestOptions) (DeletePetResponseObject, error) {
+	id := opts.PathParams.ID
+	switch {
+	case id == "locked":
+		return DeletePetDefaultResponse{StatusCode: http.StatusServiceUnavailable, Body: &Error{Message: "store is locked"}}, nil
+	case s.deleted[id]:
+		return DeletePet4XXResponse{Body: &Error{Message: "pet " + id + " is already deleted"}}, nil
+	}
+	if _, ok := s.pets[id]; !ok {
+		// Variants may be returned by pointer.
+		return &DeletePet4XXResponse{StatusCode: http.StatusNotFound, Body: &Error{Message: "pet " + id + " not found"}}, nil
+	}
+	delete(s.pets, id)
+	s.deleted[id] = true
+	return DeletePet204Response{}, nil
+}
+
+func TestStrictResponses(t *testing.T) {
+	store := &petStore{
+		pets:    map[string]Pet{"1": {ID: "1", Name: "Rex"}},
+		deleted: map[string]bool{"2": true},
+	}
+	router := NewRouter(store)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("success with headers", func(t *testing.T) {
+		rr := serve(http.MethodGet, "/pets/1", "")
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "3", rr.Header().Get("X-Version"))
+		assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+
+		var pet Pet
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &pet))
+		assert.Equal(t, Pet{ID: "1", Name: "Rex"}, pet)
+	})
+
+	t.Run("documented error body", func(t *testing.T) {
+		rr := serve(http.MethodGet, "/pets/9", "")
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		assert.Empty(t, rr.Header().Get("X-Version"))
+
+		var resp Error
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Equal(t, "pet 9 not found", resp.Message)
+	})
+
+	t.Run("documented error without body", func(t *testing.T) {
+		rr := serve(http.MethodGet, "/pets/2", "")
+		assert.Equal(t, http.StatusGone, rr.Code)
+		assert.Empty(t, rr.Body.String())
+	})
+
+	t.Run("created and conflict", func(t *testing.T) {
+		rr := serve(http.MethodPost, "/pets", `{"id":"3","name":"Tom"}`)
+		require.Equal(t, http.StatusCreated, rr.Code)
+		assert.Equal(t, "/pets/3", rr.Header().Get("Location"))
+
+		rr = serve(http.MethodPost, "/pets", `{"id":"3","name":"Tom"}`)
+		require.Equal(t, http.StatusConflict, rr.Code)
+		assert.JSONEq(t, `{"message":"pet 3 exists"}`, rr.Body.String())
+	})
+
+	t.Run("range with its own status", func(t *testing.T) {
+		rr := serve(http.MethodDelete, "/pets/1", "")
+		require.Equal(t, http.StatusNoContent, rr.Code)
+
+		rr = serve(http.MethodDelete, "/pets/1", "")
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.JSONEq(t, `{"message":"pet 1 is already deleted"}`, rr.Body.String())
+
+		rr = serve(http.MethodDelete, "/pets/9", "")
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		assert.JSONEq(t, `{"message":"pet 9 not found"}`, rr.Body.String())
+	})
+
+	t.Run("default response", func(t *testing.T) {
+		rr := serve(http.MethodDelete, "/pets/locked", "")
+		require.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		assert.JSONEq(t, `{"message":"store is locked"}`, rr.Body.String())
+	})
+}
+
+// nilResponse returns a nil pointer, which is not a response.
+type nilResponse struct {
+	petStore
+}
+
+func (s *nilResponse) GetPet(_ context.Context, _ *GetPetServiceRequestOptions) (GetPetResponseObject, error) {
+	return (*GetPet410Response)(nil), nil
+}
+
+func TestStrictResponses_UnexpectedType(t *testing.T) {
+	router := NewRouter(&nilResponse{})
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/pets/1", nil))
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+
+	var resp OapiErrorResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, "GetPet", resp.OperationID)
+	assert.Equal(t, "unexpected response type *api.GetPet410Response", resp.Error)
+}
@@ -0,0 +1,617 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+// OapiErrorKind represents the type of error that occurred during request processing.
+type OapiErrorKind int
+
+const (
+	// OapiErrorKindParse indicates a parameter parsing error (invalid path/query/header parameter).
+	OapiErrorKindParse OapiErrorKind = iota
+
+	// OapiErrorKindDecode indicates a request body decoding error (invalid JSON, form data, etc.).
+	OapiErrorKindDecode
+
+	// OapiErrorKindValidation indicates a request validation error (failed schema validation).
+	OapiErrorKindValidation
+
+	// OapiErrorKindService indicates a service/business logic error returned by the service implementation.
+	OapiErrorKindService
+)
+
+// OapiHandlerError represents an error that occurred during request handling (parse, decode, validation).
+// When no typed error response is configured in the OpenAPI spec, this error type is used.
+// Custom error handlers can type-assert to this type to access error details.
+type OapiHandlerError struct {
+	Kind          OapiErrorKind
+	OperationID   string
+	Message       string
+	ParamName     string
+	ParamLocation string
+}
+
+func (e OapiHandlerError) Error() string {
+	return e.Message
+}
+
+// OapiErrorResponse is the default JSON error response structure used by OapiDefaultErrorHandler.
+type OapiErrorResponse struct {
+	Error         string `json:"error"`
+	OperationID   string `json:"operation_id,omitempty"`
+	ParamName     string `json:"param_name,omitempty"`
+	ParamLocation string `json:"param_location,omitempty"`
+}
+
+// OapiErrorHandler handles errors that occur during request processing.
+// Implement this interface to customize error responses, logging, and metrics.
+type OapiErrorHandler interface {
+	// HandleError writes an error response to w with the given status code.
+	// The err is either an OapiHandlerError (for parse/decode/validation errors)
+	// or a typed error matching the OpenAPI spec's error response schema.
+	HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error)
+}
+
+// OapiDefaultErrorHandler provides the default error handling behavior.
+// It writes JSON error responses. For OapiHandlerError, it uses OapiErrorResponse.
+// For typed errors (from OpenAPI spec), it encodes them directly.
+type OapiDefaultErrorHandler struct{}
+
+// HandleError implements OapiErrorHandler with default JSON error responses.
+func (h *OapiDefaultErrorHandler) HandleError(w http.ResponseWriter, r *http.Request, statusCode int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if handlerErr, ok := err.(OapiHandlerError); ok {
+		_ = json.NewEncoder(w).Encode(OapiErrorResponse{
+			Error:         handlerErr.Message,
+			OperationID:   handlerErr.OperationID,
+			ParamName:     handlerErr.ParamName,
+			ParamLocation: handlerErr.ParamLocation,
+		})
+		return
+	}
+
+	// Typed error from OpenAPI spec - encode directly
+	_ = json.NewEncoder(w).Encode(err)
+}
+
+// ServiceInterface defines the service interface for business logic.
+type ServiceInterface interface {
+	// CreatePet Create a pet
+	CreatePet(ctx context.Context, opts *CreatePetServiceRequestOptions) (CreatePetResponseObject, error)
+	// GetPet Get a pet
+	GetPet(ctx context.Context, opts *GetPetServiceRequestOptions) (GetPetResponseObject, error)
+	// DeletePet Delete a pet
+	DeletePet(ctx context.Context, opts *DeletePetServiceRequestOptions) (DeletePetResponseObject, error)
+}
+
+// HTTPAdapter adapts the ServiceInterface to HTTP handlers.
+// This struct is generated and should not be modified.
+type HTTPAdapter struct {
+	svc        ServiceInterface
+	errHandler OapiErrorHandler
+	codecs     *runtime.CodecRegistry
+}
+
+// NewHTTPAdapter creates a new HTTPAdapter wrapping the given service.
+// If errHandler is nil, OapiDefaultErrorHandler is used.
+func NewHTTPAdapter(svc ServiceInterface, errHandler OapiErrorHandler) *HTTPAdapter {
+	if errHandler == nil {
+		errHandler = &OapiDefaultErrorHandler{}
+	}
+	return &HTTPAdapter{svc: svc, errHandler: errHandler}
+}
+
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If codecs is nil, runtime.DefaultCodecs is used.
+func (a *HTTPAdapter) WithCodecs(codecs *runtime.CodecRegistry) *HTTPAdapter {
+	a.codecs = codecs
+	return a
+}
+
+// CreatePet handles POST /pets
+func (a *HTTPAdapter) CreatePet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := &CreatePetServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse request body
+	defer r.Body.Close()
+	if err := runtime.DecompressRequestBody(r, 32<<20); err != nil {
+		a.errHandler.HandleError(w, r, runtime.DecompressErrorStatus(err, http.StatusBadRequest), OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreatePet",
+			Message:     err.Error(),
+		})
+		return
+	}
+	var body CreatePetBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		a.errHandler.HandleError(w, r, http.StatusBadRequest, OapiHandlerError{
+			Kind:        OapiErrorKindDecode,
+			OperationID: "CreatePet",
+			Message:     err.Error(),
+		})
+		return
+	}
+	opts.Body = &body
+
+	// Call business logic
+	resp, err := a.svc.CreatePet(ctx, opts)
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// The service may return the response by value or by pointer.
+	switch v := resp.(type) {
+	case *CreatePet201Response:
+		if v != nil {
+			resp = *v
+		}
+	case *CreatePet409Response:
+		if v != nil {
+			resp = *v
+		}
+	}
+	switch resp := resp.(type) {
+	case CreatePet201Response:
+		w.Header().Set("Location", fmt.Sprint(resp.Headers.Location))
+		status := 201
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if resp.Body != nil {
+			_ = json.NewEncoder(w).Encode(resp.Body)
+		}
+	case CreatePet409Response:
+		status := 409
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if resp.Body != nil {
+			_ = json.NewEncoder(w).Encode(resp.Body)
+		}
+	default:
+		a.errHandler.HandleError(w, r, http.StatusInternalServerError, OapiHandlerError{
+			Kind:        OapiErrorKindService,
+			OperationID: "CreatePet",
+			Message:     fmt.Sprintf("unexpected response type %T", resp),
+		})
+	}
+}
+
+// GetPet handles GET /pets/{id}
+func (a *HTTPAdapter) GetPet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := &GetPetServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &GetPetPath{}
+	pathParamIDStr := r.PathValue("id")
+	pathParams.ID = pathParamIDStr
+	opts.PathParams = pathParams
+
+	// Call business logic
+	resp, err := a.svc.GetPet(ctx, opts)
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// The service may return the response by value or by pointer.
+	switch v := resp.(type) {
+	case *GetPet200Response:
+		if v != nil {
+			resp = *v
+		}
+	case *GetPet404Response:
+		if v != nil {
+			resp = *v
+		}
+	case *GetPet410Response:
+		if v != nil {
+			resp = *v
+		}
+	}
+	switch resp := resp.(type) {
+	case GetPet200Response:
+		if resp.Headers.XVersion != nil {
+			w.Header().Set("X-Version", fmt.Sprint(*resp.Headers.XVersion))
+		}
+		status := 200
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if resp.Body != nil {
+			_ = json.NewEncoder(w).Encode(resp.Body)
+		}
+	case GetPet404Response:
+		status := 404
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if resp.Body != nil {
+			_ = json.NewEncoder(w).Encode(resp.Body)
+		}
+	case GetPet410Response:
+		status := 410
+		w.WriteHeader(status)
+	default:
+		a.errHandler.HandleError(w, r, http.StatusInternalServerError, OapiHandlerError{
+			Kind:        OapiErrorKindService,
+			OperationID: "GetPet",
+			Message:     fmt.Sprintf("unexpected response type %T", resp),
+		})
+	}
+}
+
+// DeletePet handles DELETE /pets/{id}
+func (a *HTTPAdapter) DeletePet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	opts := &DeletePetServiceRequestOptions{}
+	opts.RawRequest = r
+
+	// Parse path parameters
+	pathParams := &DeletePetPath{}
+	pathParamIDStr := r.PathValue("id")
+	pathParams.ID = pathParamIDStr
+	opts.PathParams = pathParams
+
+	// Call business logic
+	resp, err := a.svc.DeletePet(ctx, opts)
+	if err != nil {
+		code := http.StatusInternalServerError
+		a.errHandler.HandleError(w, r, code, err)
+		return
+	}
+
+	// The service may return the response by value or by pointer.
+	switch v := resp.(type) {
+	case *DeletePet204Response:
+		if v != nil {
+			resp = *v
+		}
+	case *DeletePet4XXResponse:
+		if v != nil {
+			resp = *v
+		}
+	case *DeletePetDefaultResponse:
+		if v != nil {
+			resp = *v
+		}
+	}
+	switch resp := resp.(type) {
+	case DeletePet204Response:
+		status := 204
+		w.WriteHeader(status)
+	case DeletePet4XXResponse:
+		status := 400
+		if resp.StatusCode != 0 {
+			status = resp.StatusCode
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if resp.Body != nil {
+			_ = json.NewEncoder(w).Encode(resp.Body)
+		}
+	case DeletePetDefaultResponse:
+		status := 500
+		if resp.StatusCode != 0 {
+			status = resp.StatusCode
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if resp.Body != nil {
+			_ = json.NewEncoder(w).Encode(resp.Body)
+		}
+	default:
+		a.errHandler.HandleError(w, r, http.StatusInternalServerError, OapiHandlerError{
+			Kind:        OapiErrorKindService,
+			OperationID: "DeletePet",
+			Message:     fmt.Sprintf("unexpected response type %T", resp),
+		})
+	}
+}
+
+// RouterOption is a function that configures the router.
+type RouterOption func(*routerConfig)
+
+type routerConfig struct {
+	middlewares []func(http.Handler) http.Handler
+	errHandler  OapiErrorHandler
+	codecs      *runtime.CodecRegistry
+}
+
+// WithMiddleware adds middleware to the router.
+func WithMiddleware(mw func(http.Handler) http.Handler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.middlewares = append(cfg.middlewares, mw)
+	}
+}
+
+// WithErrorHandler sets a custom error handler for the router.
+// If not set, OapiDefaultErrorHandler is used.
+func WithErrorHandler(h OapiErrorHandler) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.errHandler = h
+	}
+}
+
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If not set, runtime.DefaultCodecs is used.
+func WithCodecs(codecs *runtime.CodecRegistry) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.codecs = codecs
+	}
+}
+
+// NewRouter creates a new http.ServeMux with the given service implementation.
+func NewRouter(svc ServiceInterface, opts ...RouterOption) *http.ServeMux {
+	cfg := &routerConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	adapter := NewHTTPAdapter(svc, cfg.errHandler).WithCodecs(cfg.codecs)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /pets", applyMiddleware(http.HandlerFunc(adapter.CreatePet), cfg.middlewares...))
+	mux.HandleFunc("GET /pets/{id}", applyMiddleware(http.HandlerFunc(adapter.GetPet), cfg.middlewares...))
+	mux.HandleFunc("DELETE /pets/{id}", applyMiddleware(http.HandlerFunc(adapter.DeletePet), cfg.middlewares...))
+
+	return mux
+}
+
+// applyMiddleware wraps a handler with the given middleware chain.
+func applyMiddleware(h http.Handler, middlewares ...func(http.Handler) http.Handler) http.HandlerFunc {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h.ServeHTTP
+}
+
+type GetPetPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (g GetPetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(g))
+}
+
+type DeletePetPath struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func (d DeletePetPath) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(d))
+}
+
+type CreatePetBody = Pet
+
+// CreatePetResponseObject is the response of CreatePet, one of:
+//   - CreatePet201Response
+//   - CreatePet409Response
+type CreatePetResponseObject interface {
+	isCreatePetResponseObject()
+}
+
+// CreatePet201Response is the 201 response of CreatePet.
+// Pet created
+type CreatePet201Response struct {
+	Body    *CreatePetResponse
+	Headers CreatePet201ResponseHeaders
+}
+
+func (CreatePet201Response) isCreatePetResponseObject() {}
+
+// CreatePet201ResponseHeaders are the headers of CreatePet201Response.
+// Required headers are always written, optional ones are pointers and not written when nil.
+type CreatePet201ResponseHeaders struct {
+	Location string
+}
+
+// CreatePet409Response is the 409 response of CreatePet.
+// A pet with this ID exists
+type CreatePet409Response struct {
+	Body *CreatePetErrorResponse
+}
+
+func (CreatePet409Response) isCreatePetResponseObject() {}
+
+// GetPetResponseObject is the response of GetPet, one of:
+//   - GetPet200Response
+//   - GetPet404Response
+//   - GetPet410Response
+type GetPetResponseObject interface {
+	isGetPetResponseObject()
+}
+
+// GetPet200Response is the 200 response of GetPet.
+// The pet
+type GetPet200Response struct {
+	Body    *GetPetResponse
+	Headers GetPet200ResponseHeaders
+}
+
+func (GetPet200Response) isGetPetResponseObject() {}
+
+// GetPet200ResponseHeaders are the headers of GetPet200Response.
+// Required headers are always written, optional ones are pointers and not written when nil.
+type GetPet200ResponseHeaders struct {
+	XVersion *int
+}
+
+// GetPet404Response is the 404 response of GetPet.
+// Pet not found
+type GetPet404Response struct {
+	Body *GetPetErrorResponse
+}
+
+func (GetPet404Response) isGetPetResponseObject() {}
+
+// GetPet410Response is the 410 response of GetPet.
+// Pet was deleted
+type GetPet410Response struct {
+}
+
+func (GetPet410Response) isGetPetResponseObject() {}
+
+// DeletePetResponseObject is the response of DeletePet, one of:
+//   - DeletePet204Response
+//   - DeletePet4XXResponse
+//   - DeletePetDefaultResponse
+type DeletePetResponseObject interface {
+	isDeletePetResponseObject()
+}
+
+// DeletePet204Response is the 204 response of DeletePet.
+// Pet deleted
+type DeletePet204Response struct {
+}
+
+func (DeletePet204Response) isDeletePetResponseObject() {}
+
+// DeletePet4XXResponse is the 4XX response of DeletePet.
+// The pet cannot be deleted
+type DeletePet4XXResponse struct {
+	// StatusCode is the status of the response, 400 if zero.
+	StatusCode int
+	Body       *DeletePetErrorResponse
+}
+
+func (DeletePet4XXResponse) isDeletePetResponseObject() {}
+
+// DeletePetDefaultResponse is the default response of DeletePet.
+// Unexpected error
+type DeletePetDefaultResponse struct {
+	// StatusCode is the status of the response, 500 if zero.
+	StatusCode int
+	Body       *DeletePetErrorResponseDefault
+}
+
+func (DeletePetDefaultResponse) isDeletePetResponseObject() {}
+
+type CreatePetResponse = Pet
+
+type CreatePetErrorResponse = Error
+
+type GetPetResponse = Pet
+
+type GetPetErrorResponse = Error
+
+type DeletePetErrorResponse = Error
+
+type DeletePetErrorResponseDefault = Error
+
+// CreatePetServiceRequestOptions holds all parameters for the CreatePet operation.
+type CreatePetServiceRequestOptions struct {
+	Body *CreatePetBody
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *CreatePetServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.Body != nil {
+		if v, ok := any(o.Body).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Body", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// GetPetServiceRequestOptions holds all parameters for the GetPet operation.
+type GetPetServiceRequestOptions struct {
+	PathParams *GetPetPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *GetPetServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+// DeletePetServiceRequestOptions holds all parameters for the DeletePet operation.
+type DeletePetServiceRequestOptions struct {
+	PathParams *DeletePetPath
+	// RawRequest provides access to the underlying HTTP request for custom content type handling.
+	RawRequest *http.Request
+}
+
+// Validate validates all the fields in the options.
+func (o *DeletePetServiceRequestOptions) Validate() error {
+	var errors runtime.ValidationErrors
+
+	if o.PathParams != nil {
+		if v, ok := any(o.PathParams).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("PathParams", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return errors
+}
+
+type Pet struct {
+	ID   string `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func (p Pet) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(p))
+}
+
+type Error struct {
+	Message string `json:"message" validate:"required"`
+}
+
+func (e Error) Validate() error {
+	return runtime.ConvertValidatorError(typesValidator.Struct(e))
+}
+
+func (s Error) Error() string {
+	return "unmapped client error"
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -106,6 +106,7 @@ type ServiceInterface interface {
 type HTTPAdapter struct {
 	svc        ServiceInterface
 	errHandler OapiErrorHandler
+	codecs     *runtime.CodecRegistry
 }
 
 // NewHTTPAdapter creates a new HTTPAdapter wrapping the given service.
@@ -117,6 +118,13 @@ func NewHTTPAdapter(svc ServiceInterface, errHandler OapiErrorHandler) *HTTPAdap
 	return &HTTPAdapter{svc: svc, errHandler: errHandler}
 }
 
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If codecs is nil, runtime.DefaultCodecs is used.
+func (a *HTTPAdapter) WithCodecs(codecs *runtime.CodecRegistry) *HTTPAdapter {
+	a.codecs = codecs
+	return a
+}
+
 // HealthCheck handles GET /health
 func (a *HTTPAdapter) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -212,6 +220,10 @@ func (a *HTTPAdapter) CreateUser(w http.ResponseWriter, r *http.Request) {
 
 	// Parse request body
 	defer r.Body.Close()
+	if err := runtime.DecompressRequestBody(r, 32<<20); err != nil {
+		a.errHandler.HandleError(w, r, runtime.DecompressErrorStatus(err, 400), NewCreateUserErrorResponse(err.Error()))
+		return
+	}
 	var body CreateUserBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		a.errHandler.HandleError(w, r, 400, NewCreateUserErrorResponse(err.Error()))
@@ -335,6 +347,7 @@ type RouterOption func(*routerConfig)
 type routerConfig struct {
 	middlewares []func(fasthttp.RequestHandler) fasthttp.RequestHandler
 	errHandler  OapiErrorHandler
+	codecs      *runtime.CodecRegistry
 }
 
 // WithMiddleware adds middleware to the router.
@@ -352,6 +365,14 @@ func WithErrorHandler(h OapiErrorHandler) RouterOption {
 	}
 }
 
+// WithCodecs sets the codecs used for bodies of the media types listed in generate.codecs.
+// If not set, runtime.DefaultCodecs is used.
+func WithCodecs(codecs *runtime.CodecRegistry) RouterOption {
+	return func(cfg *routerConfig) {
+		cfg.codecs = codecs
+	}
+}
+
 // fasthttpHandler wraps an http.HandlerFunc with path param injection for fasthttp.
 func fasthttpHandler(h http.HandlerFunc, pathParams ...string) fasthttp.RequestHandler {
 	return func(ctx *fasthttp.RequestCtx) {
@@ -375,7 +396,7 @@ func NewRouter(svc ServiceInterface, opts ...RouterOption) *router.Router {
 		opt(cfg)
 	}
 
-	httpAdapter := NewHTTPAdapter(svc, cfg.errHandler)
+	httpAdapter := NewHTTPAdapter(svc, cfg.errHandler).WithCodecs(cfg.codecs)
 	r := router.New()
 	r.GET("/health", fasthttpadaptor.NewFastHTTPHandlerFunc(httpAdapter.HealthCheck))
 	r.GET("/users", fasthttpadaptor.NewFastHTTPHandlerFunc(httpAdapter.ListUsers))
@@ -406,7 +427,7 @@ func Handler(svc ServiceInterface, opts ...RouterOption) fasthttp.RequestHandler
 		opt(cfg)
 	}
 
-	httpAdapter := NewHTTPAdapter(svc, cfg.errHandler)
+	httpAdapter := NewHTTPAdapter(svc, cfg.errHandler).WithCodecs(cfg.codecs)
 	r := router.New()
 	r.GET("/health", fasthttpadaptor.NewFastHTTPHandlerFunc(httpAdapter.HealthCheck))
 	r.GET("/users", fasthttpadaptor.NewFastHTTPHandlerFunc(httpAdapter.ListUsers))
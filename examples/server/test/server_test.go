@@ -2,6 +2,7 @@ package test
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -196,6 +197,46 @@ func TestCreateUser_JSONBody(t *testing.T) {
 	}
 }
 
+func TestCreateUser_GzipBody(t *testing.T) {
+	for _, tc := range testServers() {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			zw := gzip.NewWriter(&buf)
+			_, _ = zw.Write([]byte(`{"name": "Dana", "email": "dana@example.com"}`))
+			require.NoError(t, zw.Close())
+
+			req := httptest.NewRequest("POST", "/users", &buf)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Content-Encoding", "gzip")
+			resp, err := tc.handler.Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+			var user map[string]any
+			err = json.NewDecoder(resp.Body).Decode(&user)
+			require.NoError(t, err)
+			assert.Equal(t, "Dana", user["name"])
+		})
+	}
+}
+
+func TestCreateUser_UnsupportedEncoding(t *testing.T) {
+	for _, tc := range testServers() {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/users", strings.NewReader(`{"name": "Dana"}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Content-Encoding", "br")
+			resp, err := tc.handler.Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+		})
+	}
+}
+
 func TestGetUser_PathParam(t *testing.T) {
 	for _, tc := range testServers() {
 		t.Run(tc.name, func(t *testing.T) {
@@ -0,0 +1,223 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type Point struct {
+	Lat float32
+	Lng float32
+}
+
+func (p Point) Validate() error {
+	var errors runtime.ValidationErrors
+	if err := typesValidator.Var(p.Lat, "gte=-90,lte=90"); err != nil {
+		errors = errors.Append("Lat", err)
+	}
+	if err := typesValidator.Var(p.Lng, "gte=-180,lte=180"); err != nil {
+		errors = errors.Append("Lng", err)
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+// MarshalJSON encodes Point as a JSON array
+func (p Point) MarshalJSON() ([]byte, error) {
+	items := []any{p.Lat, p.Lng}
+	return json.Marshal(items)
+}
+
+// UnmarshalJSON decodes Point from a JSON array
+func (p *Point) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if len(items) < 2 {
+		return fmt.Errorf("expected at least 2 items, got %d", len(items))
+	}
+	if len(items) > 2 {
+		return fmt.Errorf("expected at most 2 items, got %d", len(items))
+	}
+	if err := json.Unmarshal(items[0], &p.Lat); err != nil {
+		return fmt.Errorf("error reading item 0: %w", err)
+	}
+	if err := json.Unmarshal(items[1], &p.Lng); err != nil {
+		return fmt.Errorf("error reading item 1: %w", err)
+	}
+	return nil
+}
+
+// Sample Time-series sample with optional label and trailing tags.
+type Sample struct {
+	Item0 time.Time
+	Item1 float32
+	Item2 *string
+	Rest  []string
+}
+
+func (s Sample) Validate() error {
+	var errors runtime.ValidationErrors
+	if s.Item2 != nil {
+		if err := typesValidator.Var(s.Item2, "max=20"); err != nil {
+			errors = errors.Append("Item2", err)
+		}
+	}
+	if len(s.Rest) > 2 {
+		errors = errors.Add("Rest", fmt.Sprintf("must have at most 5 items, got %d", 3+len(s.Rest)))
+	}
+	for i, item := range s.Rest {
+		if err := typesValidator.Var(item, "min=1"); err != nil {
+			errors = errors.Append(fmt.Sprintf("Rest[%d]", i), err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+// MarshalJSON encodes Sample as a JSON array
+func (s Sample) MarshalJSON() ([]byte, error) {
+	items := []any{s.Item0, s.Item1, s.Item2}
+	n := 2
+	if s.Item2 != nil {
+		n = 3
+	}
+	if len(s.Rest) > 0 {
+		n = 3
+	}
+	items = items[:n]
+	for _, item := range s.Rest {
+		items = append(items, item)
+	}
+	return json.Marshal(items)
+}
+
+// UnmarshalJSON decodes Sample from a JSON array
+func (s *Sample) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if len(items) < 2 {
+		return fmt.Errorf("expected at least 2 items, got %d", len(items))
+	}
+	if err := json.Unmarshal(items[0], &s.Item0); err != nil {
+		return fmt.Errorf("error reading item 0: %w", err)
+	}
+	if err := json.Unmarshal(items[1], &s.Item1); err != nil {
+		return fmt.Errorf("error reading item 1: %w", err)
+	}
+	if len(items) > 2 {
+		if err := json.Unmarshal(items[2], &s.Item2); err != nil {
+			return fmt.Errorf("error reading item 2: %w", err)
+		}
+	}
+	if len(items) > 3 {
+		s.Rest = make([]string, len(items)-3)
+		for i, raw := range items[3:] {
+			if err := json.Unmarshal(raw, &s.Rest[i]); err != nil {
+				return fmt.Errorf("error reading item %d: %w", 3+i, err)
+			}
+		}
+	}
+	return nil
+}
+
+type Route struct {
+	Start     *Point         `json:"start,omitempty"`
+	Waypoints []Point        `json:"waypoints,omitempty"`
+	Segment   *Route_Segment `json:"segment,omitempty"`
+}
+
+func (r Route) Validate() error {
+	var errors runtime.ValidationErrors
+	if r.Start != nil {
+		if v, ok := any(r.Start).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Start", err)
+			}
+		}
+	}
+	for i, item := range r.Waypoints {
+		if v, ok := any(item).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append(fmt.Sprintf("Waypoints[%d]", i), err)
+			}
+		}
+	}
+	if r.Segment != nil {
+		if v, ok := any(r.Segment).(runtime.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errors = errors.Append("Segment", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Route_Segment struct {
+	Item0 int
+	Item1 Route_Segment_Item1
+}
+
+func (r Route_Segment) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := any(r.Item1).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Item1", err)
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+// MarshalJSON encodes Route_Segment as a JSON array
+func (r Route_Segment) MarshalJSON() ([]byte, error) {
+	items := []any{r.Item0, r.Item1}
+	return json.Marshal(items)
+}
+
+// UnmarshalJSON decodes Route_Segment from a JSON array
+func (r *Route_Segment) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if len(items) < 2 {
+		return fmt.Errorf("expected at least 2 items, got %d", len(items))
+	}
+	if err := json.Unmarshal(items[0], &r.Item0); err != nil {
+		return fmt.Errorf("error reading item 0: %w", err)
+	}
+	if err := json.Unmarshal(items[1], &r.Item1); err != nil {
+		return fmt.Errorf("error reading item 1: %w", err)
+	}
+	return nil
+}
+
+type Route_Segment_Item1 struct {
+	Name *string `json:"name,omitempty"`
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
@@ -0,0 +1,101 @@
+package gen
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+func TestPoint(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		var p Point
+		require.NoError(t, json.Unmarshal([]byte(`[52.37, 4.89]`), &p))
+		assert.Equal(t, Point{Lat: 52.37, Lng: 4.89}, p)
+
+		data, err := json.Marshal(p)
+		require.NoError(t, err)
+		assert.JSONEq(t, `[52.37, 4.89]`, string(data))
+	})
+
+	t.Run("too few items", func(t *testing.T) {
+		var p Point
+		assert.EqualError(t, json.Unmarshal([]byte(`[52.37]`), &p), "expected at least 2 items, got 1")
+	})
+
+	t.Run("items false", func(t *testing.T) {
+		var p Point
+		assert.EqualError(t, json.Unmarshal([]byte(`[1, 2, 3]`), &p), "expected at most 2 items, got 3")
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		err := Point{Lat: 91, Lng: 0}.Validate()
+		require.Error(t, err)
+
+		var errs runtime.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 1)
+		assert.Equal(t, "Lat", errs[0].Field)
+
+		assert.NoError(t, Point{Lat: -90, Lng: 180}.Validate())
+	})
+}
+
+func TestSample(t *testing.T) {
+	ts := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("optional element missing", func(t *testing.T) {
+		var s Sample
+		require.NoError(t, json.Unmarshal([]byte(`["2026-01-02T03:04:05Z", 1.5]`), &s))
+		assert.Equal(t, ts, s.Item0)
+		assert.Equal(t, float32(1.5), s.Item1)
+		assert.Nil(t, s.Item2)
+
+		data, err := json.Marshal(s)
+		require.NoError(t, err)
+		assert.JSONEq(t, `["2026-01-02T03:04:05Z", 1.5]`, string(data))
+	})
+
+	t.Run("trailing items", func(t *testing.T) {
+		var s Sample
+		require.NoError(t, json.Unmarshal([]byte(`["2026-01-02T03:04:05Z", 1.5, "cpu", "a", "b"]`), &s))
+		assert.Equal(t, "cpu", *s.Item2)
+		assert.Equal(t, []string{"a", "b"}, s.Rest)
+		assert.NoError(t, s.Validate())
+
+		data, err := json.Marshal(s)
+		require.NoError(t, err)
+		assert.JSONEq(t, `["2026-01-02T03:04:05Z", 1.5, "cpu", "a", "b"]`, string(data))
+	})
+
+	t.Run("trailing items validation", func(t *testing.T) {
+		s := Sample{Item0: ts, Item1: 1, Rest: []string{"a", ""}}
+		err := s.Validate()
+		require.Error(t, err)
+
+		var errs runtime.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 1)
+		assert.Equal(t, "Rest[1]", errs[0].Field)
+	})
+
+	t.Run("max items", func(t *testing.T) {
+		s := Sample{Item0: ts, Item1: 1, Rest: []string{"a", "b", "c"}}
+		assert.EqualError(t, s.Validate(), "Rest must have at most 5 items, got 6")
+	})
+}
+
+func TestRoute(t *testing.T) {
+	var r Route
+	require.NoError(t, json.Unmarshal([]byte(`{"start": [1, 2], "waypoints": [[3, 4]], "segment": [7, {"name": "a"}]}`), &r))
+	assert.Equal(t, &Point{Lat: 1, Lng: 2}, r.Start)
+	assert.Equal(t, []Point{{Lat: 3, Lng: 4}}, r.Waypoints)
+	assert.Equal(t, 7, r.Segment.Item0)
+	assert.Equal(t, "a", *r.Segment.Item1.Name)
+
+	r.Waypoints[0].Lat = 100
+	assert.Error(t, r.Validate())
+}
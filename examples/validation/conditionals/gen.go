@@ -0,0 +1,163 @@
+// Code generated by oapi-codegen. DO NOT EDIT.
+
+package gen
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+type AddressCountry string
+
+const (
+	CA AddressCountry = "CA"
+	NL AddressCountry = "NL"
+	US AddressCountry = "US"
+)
+
+// Validate checks if the AddressCountry value is valid
+func (a AddressCountry) Validate() error {
+	switch a {
+	case CA, NL, US:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid AddressCountry value, got: %v", a))
+	}
+}
+
+type ShippingCountry string
+
+const (
+	ShippingCountryCA ShippingCountry = "CA"
+	ShippingCountryNL ShippingCountry = "NL"
+	ShippingCountryUS ShippingCountry = "US"
+)
+
+// Validate checks if the ShippingCountry value is valid
+func (s ShippingCountry) Validate() error {
+	switch s {
+	case ShippingCountryCA, ShippingCountryNL, ShippingCountryUS:
+		return nil
+	default:
+		return runtime.NewValidationErrorsFromString("Enum", fmt.Sprintf("must be a valid ShippingCountry value, got: %v", s))
+	}
+}
+
+type Payment struct {
+	CardNumber     *string `json:"cardNumber,omitempty"`
+	Expiry         *string `json:"expiry,omitempty"`
+	Cvv            *string `json:"cvv,omitempty"`
+	BillingAddress *string `json:"billingAddress,omitempty"`
+}
+
+func (p Payment) Validate() error {
+	var errors runtime.ValidationErrors
+	if p.CardNumber != nil {
+		if p.Expiry == nil {
+			errors = errors.Add("Expiry", "is required when cardNumber is present")
+		}
+		if p.Cvv == nil {
+			errors = errors.Add("Cvv", "is required when cardNumber is present")
+		}
+	}
+	if p.BillingAddress != nil {
+		if p.CardNumber == nil {
+			errors = errors.Add("CardNumber", "is required when billingAddress is present")
+		}
+		if p.CardNumber != nil {
+			if err := typesValidator.Var(*p.CardNumber, "min=12"); err != nil {
+				errors = errors.Append("CardNumber", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Address struct {
+	Country    AddressCountry `json:"country" validate:"required"`
+	PostalCode *string        `json:"postalCode,omitempty"`
+	State      *string        `json:"state,omitempty"`
+}
+
+func (a Address) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := any(a.Country).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Country", err)
+		}
+	}
+	if a.Country == "US" {
+		if a.State == nil {
+			errors = errors.Add("State", "is required")
+		}
+		if a.PostalCode != nil {
+			if !runtime.MatchPattern("^[0-9]{5}$", string(*a.PostalCode)) {
+				errors = errors.Add("PostalCode", "must match pattern \"^[0-9]{5}$\"")
+			}
+		}
+	} else {
+		if a.PostalCode != nil {
+			if err := typesValidator.Var(*a.PostalCode, "max=10"); err != nil {
+				errors = errors.Append("PostalCode", err)
+			}
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+type Shipping struct {
+	Country    ShippingCountry `json:"country" validate:"required"`
+	PostalCode *string         `json:"postalCode,omitempty"`
+	State      *string         `json:"state,omitempty"`
+	Express    *bool           `json:"express,omitempty"`
+	Phone      *string         `json:"phone,omitempty"`
+}
+
+func (s Shipping) Validate() error {
+	var errors runtime.ValidationErrors
+	if v, ok := any(s.Country).(runtime.Validator); ok {
+		if err := v.Validate(); err != nil {
+			errors = errors.Append("Country", err)
+		}
+	}
+	if s.Country == "US" {
+		if s.State == nil {
+			errors = errors.Add("State", "is required")
+		}
+		if s.PostalCode != nil {
+			if !runtime.MatchPattern("^[0-9]{5}$", string(*s.PostalCode)) {
+				errors = errors.Add("PostalCode", "must match pattern \"^[0-9]{5}$\"")
+			}
+		}
+	} else {
+		if s.PostalCode != nil {
+			if err := typesValidator.Var(*s.PostalCode, "max=10"); err != nil {
+				errors = errors.Append("PostalCode", err)
+			}
+		}
+	}
+	if s.Express != nil && *s.Express {
+		if s.Phone == nil {
+			errors = errors.Add("Phone", "is required")
+		}
+	}
+	if len(errors) == 0 {
+		return nil
+	}
+	return errors
+}
+
+var typesValidator *validator.Validate
+
+func init() {
+	typesValidator = validator.New(validator.WithRequiredStructEnabled())
+	runtime.RegisterCustomTypeFunc(typesValidator)
+}
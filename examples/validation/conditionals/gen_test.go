@@ -0,0 +1,100 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
+)
+
+func TestPayment_DependentRequired(t *testing.T) {
+	t.Run("valid without card", func(t *testing.T) {
+		assert.NoError(t, Payment{}.Validate())
+	})
+
+	t.Run("card requires expiry and cvv", func(t *testing.T) {
+		err := Payment{CardNumber: runtime.Ptr("4111111111111111")}.Validate()
+		require.Error(t, err)
+
+		var errs runtime.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 2)
+		assert.Equal(t, "Expiry", errs[0].Field)
+		assert.Equal(t, "is required when cardNumber is present", errs[0].Message)
+		assert.Equal(t, "Cvv", errs[1].Field)
+	})
+
+	t.Run("valid with card", func(t *testing.T) {
+		p := Payment{
+			CardNumber: runtime.Ptr("4111111111111111"),
+			Expiry:     runtime.Ptr("12/30"),
+			Cvv:        runtime.Ptr("123"),
+		}
+		assert.NoError(t, p.Validate())
+	})
+}
+
+func TestPayment_DependentSchemas(t *testing.T) {
+	t.Run("billing address requires card", func(t *testing.T) {
+		err := Payment{BillingAddress: runtime.Ptr("Main St 1")}.Validate()
+		assert.EqualError(t, err, "CardNumber is required when billingAddress is present")
+	})
+
+	t.Run("billing address requires long card number", func(t *testing.T) {
+		p := Payment{
+			BillingAddress: runtime.Ptr("Main St 1"),
+			CardNumber:     runtime.Ptr("4111"),
+			Expiry:         runtime.Ptr("12/30"),
+			Cvv:            runtime.Ptr("123"),
+		}
+		err := p.Validate()
+		require.Error(t, err)
+
+		var errs runtime.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 1)
+		assert.Equal(t, "CardNumber", errs[0].Field)
+	})
+}
+
+func TestAddress_IfThenElse(t *testing.T) {
+	t.Run("US requires state", func(t *testing.T) {
+		err := Address{Country: US}.Validate()
+		assert.EqualError(t, err, "State is required")
+	})
+
+	t.Run("US postal code pattern", func(t *testing.T) {
+		a := Address{Country: US, State: runtime.Ptr("CA"), PostalCode: runtime.Ptr("ABC")}
+		assert.EqualError(t, a.Validate(), `PostalCode must match pattern "^[0-9]{5}$"`)
+
+		a.PostalCode = runtime.Ptr("94105")
+		assert.NoError(t, a.Validate())
+	})
+
+	t.Run("else branch", func(t *testing.T) {
+		assert.NoError(t, Address{Country: NL, PostalCode: runtime.Ptr("1011 AB")}.Validate())
+
+		err := Address{Country: NL, PostalCode: runtime.Ptr("1011 AB 12345")}.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "PostalCode")
+	})
+}
+
+func TestShipping_AllOf(t *testing.T) {
+	t.Run("conditionals of all elements apply", func(t *testing.T) {
+		err := Shipping{Country: ShippingCountryUS, Express: runtime.Ptr(true)}.Validate()
+		require.Error(t, err)
+
+		var errs runtime.ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 2)
+		assert.Equal(t, "State", errs[0].Field)
+		assert.Equal(t, "Phone", errs[1].Field)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		s := Shipping{Country: ShippingCountryCA, Express: runtime.Ptr(false)}
+		assert.NoError(t, s.Validate())
+	})
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientFake(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{
+			Name: "UsersClient",
+			Fake: true,
+		},
+	}
+
+	code := generateCode(t, readTestdata(t, "pagination.yml"), cfg).GetCombined()
+
+	assert.Contains(t, code, "var _ UsersClientInterface = (*FakeUsersClient)(nil)")
+	// ListLogs has no request options to record.
+	assert.Regexp(t, `ListLogsFunc\s+func\(ctx context\.Context\) \(\*ListLogsResponse, error\)`, code)
+	assert.Contains(t, code, `f.Record("ListLogs", nil)`)
+	assert.NotContains(t, code, "ListLogsCalls()")
+	assert.Contains(t, code, "func (f *FakeUsersClient) ListUsersAll(")
+
+	cfg.Client.Fake = false
+	codes, err := Generate([]byte(readTestdata(t, "pagination.yml")), cfg)
+	require.NoError(t, err)
+	assert.NotContains(t, codes.GetCombined(), "FakeUsersClient")
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientValidation(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	code := generateCode(t, readTestdata(t, "pagination.yml"), cfg).GetCombined()
+
+	assert.Contains(t, code, `if err = runtime.ValidateRequest(c.apiClient, "ListUsers", options); err != nil {`)
+	assert.Contains(t, code, `if err = runtime.ValidateResponse(c.apiClient, "ListUsers", resp.StatusCode, target); err != nil {`)
+	// ListLogs has no request options.
+	assert.NotContains(t, code, `runtime.ValidateRequest(c.apiClient, "ListLogs"`)
+	assert.Contains(t, code, `runtime.ValidateResponse(c.apiClient, "ListLogs", resp.StatusCode, target)`)
+	assert.Contains(t, code, "runtime.WithResponse(c.apiClient, resp))")
+	assert.NotContains(t, code, "runtime.WithStatusCode(")
+}
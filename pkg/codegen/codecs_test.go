@@ -0,0 +1,55 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodecs(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client:  true,
+			Handler: &HandlerOptions{},
+			Codecs:  []string{"application/xml", "application/*yaml"},
+		},
+	}
+
+	code := generateCode(t, readTestdata(t, "codecs.yml"), cfg).GetCombined()
+
+	t.Run("xml tags from the xml object", func(t *testing.T) {
+		assert.Regexp(t, `XMLName\s+xml\.Name\s+`+"`"+`json:"-" xml:"pet"`+"`", code)
+		assert.Regexp(t, `ID\s+int\s+`+"`"+`json:"id" validate:"required" xml:"id,attr"`+"`", code)
+		assert.Regexp(t, `Name\s+string\s+`+"`"+`json:"name" validate:"required" xml:"pet-name"`+"`", code)
+		assert.Regexp(t, `Tags\s+\[\]string\s+`+"`"+`json:"tags,omitempty" xml:"tags>tag,omitempty"`+"`", code)
+		assert.Regexp(t, `Message\s+\*string\s+`+"`"+`json:"message,omitempty" xml:"message,omitempty"`+"`", code)
+	})
+
+	t.Run("typed bodies for codec media types", func(t *testing.T) {
+		assert.NotContains(t, code, "type CreatePetResponse = []byte")
+		assert.NotContains(t, code, "type GetConfigResponse = []byte")
+		assert.Contains(t, code, "type GetReportResponse = []byte")
+	})
+
+	t.Run("media types without a codec", func(t *testing.T) {
+		cfg := cfg
+		cfg.Generate = &GenerateOptions{Client: true, Handler: &HandlerOptions{}}
+		code := generateCode(t, readTestdata(t, "codecs.yml"), cfg).GetCombined()
+		assert.Contains(t, code, "type CreatePetResponse = []byte")
+		assert.NotContains(t, code, "a.codecs.")
+	})
+}
@@ -13,6 +13,7 @@ package codegen
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pb33f/libopenapi"
 	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
@@ -27,6 +28,9 @@ type ParseContext struct {
 	Imports         []string
 	ResponseErrors  []string
 	TypeTracker     *TypeTracker
+	ClientGroups    []OperationGroupDefinition
+	ServiceGroups   []OperationGroupDefinition
+	SensitiveFields []SensitiveField
 }
 
 type operationsCollection struct {
@@ -94,16 +98,32 @@ func CreateParseContextFromModel(model *v3high.Document, cfg Configuration) (*Pa
 		return nil, nil
 	}
 
+	if err := cfg.TypeMappings.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Client.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Generate.Handler != nil {
+		if err := cfg.Generate.Handler.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	parseOptions := ParseOptions{
 		OmitDescription:        cfg.Generate.OmitDescription,
 		DefaultIntType:         cfg.Generate.DefaultIntType,
 		AlwaysPrefixEnumValues: cfg.Generate.AlwaysPrefixEnumValues,
+		OpenEnums:              cfg.Generate.OpenEnums,
 		SkipValidation:         cfg.Generate.Validation.Skip,
 		ErrorMapping:           cfg.ErrorMapping,
 		AutoExtraTags:          cfg.Generate.AutoExtraTags,
+		TypeMappings:           cfg.TypeMappings,
+		Codecs:                 cfg.Generate.Codecs,
 		typeTracker:            newTypeTracker(),
 		visited:                map[string]bool{},
 		model:                  model,
+		strictResponses:        cfg.Generate.Handler != nil && cfg.Generate.Handler.Strict,
 	}
 
 	var (
@@ -112,10 +132,16 @@ func CreateParseContextFromModel(model *v3high.Document, cfg Configuration) (*Pa
 		responseErrors []string
 	)
 
+	xmlRefs, err := findXMLRefs(model, parseOptions)
+	if err != nil {
+		return nil, err
+	}
+	parseOptions.xmlRefs = xmlRefs
+
 	// Process Components
 	typeDefs, err := collectComponentDefinitions(model, parseOptions)
 	if err != nil {
-		return nil, fmt.Errorf("error collecting component definitions: %s", err)
+		return nil, fmt.Errorf("error collecting component definitions: %w", err)
 	}
 
 	// collect operations
@@ -131,6 +157,42 @@ func CreateParseContextFromModel(model *v3high.Document, cfg Configuration) (*Pa
 		responseErrors = opColl.responseErrors
 	}
 
+	for i, op := range operations {
+		if op.paginationExt != nil {
+			operations[i].Pagination, err = resolvePagination(op, op.paginationExt, typeDefs)
+			if err != nil {
+				return nil, fmt.Errorf("error resolving x-pagination extension for %s: %w", op.ID, err)
+			}
+		}
+		if op.longRunningExt != nil {
+			operations[i].LongRunning, err = resolveLongRunning(op.longRunningExt, operations, typeDefs)
+			if err != nil {
+				return nil, fmt.Errorf("error resolving x-long-running extension for %s: %w", op.ID, err)
+			}
+		}
+	}
+
+	var clientGroups []OperationGroupDefinition
+	if cfg.Client.GroupBy == GroupByTags {
+		for i, op := range operations {
+			if len(op.Tags) > 0 {
+				operations[i].ClientGroup = tagGroupName(op.Tags[0])
+			}
+		}
+		clientGroups = groupOperationsByTag(operations, cfg.Client.MultipleTags == ClientMultipleTagsAll, "")
+		if err = checkClientGroups(clientGroups, operations, typeDefs, cfg.Client); err != nil {
+			return nil, err
+		}
+	}
+
+	var serviceGroups []OperationGroupDefinition
+	if cfg.Generate.Handler != nil && cfg.Generate.Handler.GroupBy == GroupByTags {
+		serviceGroups = groupOperationsByTag(operations, false, "Default")
+		if err = checkServiceGroups(serviceGroups, operations, typeDefs, cfg.Generate.Handler.Name); err != nil {
+			return nil, err
+		}
+	}
+
 	// Collect Schemas from components
 	for _, componentDef := range typeDefs {
 		importSchemas = append(importSchemas, componentDef.Schema)
@@ -139,13 +201,14 @@ func CreateParseContextFromModel(model *v3high.Document, cfg Configuration) (*Pa
 	// Collect Imports
 	imprts := map[string]goImport{}
 	for _, schema := range importSchemas {
-		importRes, err := collectSchemaImports(schema)
+		importRes, err := collectSchemaImports(schema, cfg.TypeMappings)
 		if err != nil {
 			return nil, fmt.Errorf("error getting schema imports: %w", err)
 		}
 		mergeImports(imprts, importRes)
 	}
 
+	sensitiveFields := collectSensitiveFields(typeDefs)
 	enums, typeDefs := filterOutEnums(typeDefs, parseOptions)
 
 	groupedTypeDefs := make(map[SpecLocation][]TypeDefinition)
@@ -188,6 +251,9 @@ func CreateParseContextFromModel(model *v3high.Document, cfg Configuration) (*Pa
 		Imports:         importMap(imprts).GoImports(),
 		ResponseErrors:  respErrs,
 		TypeTracker:     parseOptions.typeTracker,
+		ClientGroups:    clientGroups,
+		ServiceGroups:   serviceGroups,
+		SensitiveFields: sensitiveFields,
 	}, nil
 }
 
@@ -283,8 +349,14 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 				}
 			}
 
+			xmlTags, err := operationXMLTags(operation, options)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing %s extension for %s: %w", extXMLTags, operationID, err)
+			}
+			payloadOptions := options.WithXMLTags(xmlTags)
+
 			// Process Request Body
-			bodyDefinition, bodyTypeDef, err := createBodyDefinition(operationID, operation.RequestBody, options)
+			bodyDefinition, bodyTypeDef, err := createBodyDefinition(operationID, operation.RequestBody, payloadOptions)
 			if err != nil {
 				return nil, fmt.Errorf("error generating body definitions: %w", err)
 			}
@@ -298,7 +370,7 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 
 			// Process Responses
 			response := ResponseDefinition{}
-			responseDef, responseTypes, err := getOperationResponses(operationID, operation.Responses, options)
+			responseDef, responseTypes, err := getOperationResponses(operationID, operation.Responses, payloadOptions)
 			if err != nil {
 				return nil, fmt.Errorf("error getting operation responses: %w", err)
 			}
@@ -316,7 +388,17 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 			}
 
 			// Parse x-mcp extension if present
-			var mcpExt *MCPExtension
+			var (
+				mcpExt            *MCPExtension
+				deprecationReason string
+				sunset            string
+				deprecatedAt      string
+				retryable         *bool
+				idempotent        bool
+				paginationExt     *PaginationExtension
+				longRunningExt    *LongRunningExtension
+				timeout           time.Duration
+			)
 			if operation.Extensions != nil {
 				extensions := extractExtensions(operation.Extensions)
 				if mcpValue, ok := extensions[extMCP]; ok {
@@ -325,6 +407,52 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 						return nil, fmt.Errorf("error parsing x-mcp extension for %s: %w", operationID, err)
 					}
 				}
+				if reason, ok := extensions[extDeprecationReason]; ok {
+					deprecationReason, _ = parseString(reason)
+				}
+				if sunsetValue, ok := extensions[extSunset]; ok {
+					sunset, err = extParseSunset(sunsetValue)
+					if err != nil {
+						return nil, fmt.Errorf("error parsing x-sunset extension for %s: %w", operationID, err)
+					}
+				}
+				if deprecatedAtValue, ok := extensions[extDeprecatedAt]; ok {
+					deprecatedAt, err = extParseDeprecatedAt(deprecatedAtValue)
+					if err != nil {
+						return nil, fmt.Errorf("error parsing x-deprecated-at extension for %s: %w", operationID, err)
+					}
+				}
+				if retryableValue, ok := extensions[extRetryable]; ok {
+					value, err := parseBooleanValue(retryableValue)
+					if err != nil {
+						return nil, fmt.Errorf("error parsing x-retryable extension for %s: %w", operationID, err)
+					}
+					retryable = &value
+				}
+				if idempotentValue, ok := extensions[extIdempotent]; ok {
+					idempotent, err = parseBooleanValue(idempotentValue)
+					if err != nil {
+						return nil, fmt.Errorf("error parsing x-idempotent extension for %s: %w", operationID, err)
+					}
+				}
+				if paginationValue, ok := extensions[extPagination]; ok {
+					paginationExt, err = extParsePagination(paginationValue)
+					if err != nil {
+						return nil, fmt.Errorf("error parsing x-pagination extension for %s: %w", operationID, err)
+					}
+				}
+				if longRunningValue, ok := extensions[extLongRunning]; ok {
+					longRunningExt, err = extParseLongRunning(longRunningValue)
+					if err != nil {
+						return nil, fmt.Errorf("error parsing x-long-running extension for %s: %w", operationID, err)
+					}
+				}
+				if timeoutValue, ok := extensions[extTimeout]; ok {
+					timeout, err = extParseTimeout(timeoutValue)
+					if err != nil {
+						return nil, fmt.Errorf("error parsing x-timeout extension for %s: %w", operationID, err)
+					}
+				}
 			}
 
 			operations = append(operations, OperationDefinition{
@@ -334,12 +462,23 @@ func collectOperationDefinitions(model *v3high.Document, options ParseOptions) (
 				// https://datatracker.ietf.org/doc/html/rfc7231
 				Method:     strings.ToUpper(method),
 				Path:       path,
+				Tags:       operation.Tags,
 				PathParams: pathParamsDef,
 				Header:     headerDef,
 				Query:      queryParamsDef,
 				Response:   response,
 				Body:       bodyDefinition,
 				MCP:        mcpExt,
+
+				Deprecated:        operation.Deprecated != nil && *operation.Deprecated,
+				DeprecationReason: deprecationReason,
+				Sunset:            sunset,
+				DeprecatedAt:      deprecatedAt,
+				Retryable:         retryable,
+				Idempotent:        idempotent,
+				Timeout:           timeout,
+				paginationExt:     paginationExt,
+				longRunningExt:    longRunningExt,
 			})
 		}
 	}
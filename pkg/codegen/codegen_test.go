@@ -14,6 +14,7 @@ import (
 	"embed"
 	"go/format"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -32,6 +33,17 @@ func readTestdata(t *testing.T, name string) string {
 	return string(data)
 }
 
+// generateCode generates the code for spec and checks that it is valid Go source.
+func generateCode(t *testing.T, spec string, cfg Configuration) GeneratedCode {
+	t.Helper()
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+
+	_, err = format.Source([]byte(codes.GetCombined()))
+	require.NoError(t, err)
+	return codes
+}
+
 // Keep these for backward compatibility with other test files
 //
 //go:embed testdata/test_spec.yml
@@ -489,3 +501,127 @@ func TestRawContentTypesGenerateByteSlice(t *testing.T) {
 	_, err = format.Source([]byte(code))
 	require.NoError(t, err, "Generated code should compile without syntax errors")
 }
+
+func TestTypeMappings(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client:  true,
+			Handler: &HandlerOptions{},
+		},
+		TypeMappings: TypeMappings{
+			"string/decimal": {
+				Type:   "decimal.Decimal",
+				Import: &AdditionalImport{Package: "github.com/shopspring/decimal"},
+			},
+			"integer/int64":   {Type: "OrderID"},
+			"string/trace-id": {Type: "trace.ID", Import: &AdditionalImport{Alias: "trace", Package: "example.com/tracing"}},
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "type-mappings.yml")), cfg)
+	require.NoError(t, err)
+	code := codes.GetCombined()
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	// imports
+	assert.Contains(t, code, `"github.com/shopspring/decimal"`)
+	assert.Contains(t, code, `trace "example.com/tracing"`)
+
+	// schemas
+	assert.Regexp(t, `ID\s+OrderID\s+`, code)
+	assert.Regexp(t, `Total\s+decimal\.Decimal\s+`, code)
+	assert.Regexp(t, `Tax\s+\*decimal\.Decimal\s+`, code)
+	assert.Regexp(t, `Lines\s+\[\]decimal\.Decimal\s+`, code)
+	assert.Regexp(t, `Note\s+\*string\s+`, code)
+	assert.Regexp(t, `Count\s+\*int\s+`, code)
+	assert.Contains(t, code, "type OrderStatus string")
+
+	// mapped types don't keep the constraints of the primitive type
+	assert.Regexp(t, `ID\s+OrderID\s+`+"`json:\"id\" validate:\"required\"`", code)
+	assert.Regexp(t, `Total\s+decimal\.Decimal\s+`+"`json:\"total\" validate:\"required\"`", code)
+	assert.Regexp(t, `Count\s+\*int\s+`+"`json:\"count,omitempty\" validate:\"omitempty,gte=0\"`", code)
+
+	// params and headers
+	assert.Contains(t, code, "runtime.ParseString[OrderID]")
+	assert.Contains(t, code, "runtime.ParseString[decimal.Decimal]")
+	assert.Contains(t, code, "runtime.ParseString[trace.ID]")
+}
+
+func TestCassetteSensitiveData(t *testing.T) {
+	spec := `
+openapi: 3.0.0
+info: {title: Test, version: 1.0.0}
+paths:
+  /users:
+    get:
+      operationId: getUser
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/User'
+components:
+  schemas:
+    User:
+      type: object
+      properties:
+        name:
+          type: string
+        ssn:
+          type: string
+          x-sensitive-data:
+            mask: partial
+            keepSuffix: 4
+        card:
+          type: object
+          properties:
+            number:
+              type: string
+              x-sensitive-data:
+                mask: full
+`
+	cfg := Configuration{
+		PackageName: "api",
+		Output:      &Output{UseSingleFile: true},
+		Generate:    &GenerateOptions{Client: true},
+	}
+
+	codes, err := Generate([]byte(spec), cfg)
+	require.NoError(t, err)
+	code := codes.GetCombined()
+
+	_, err = format.Source([]byte(code))
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "func CassetteSensitiveData() runtime.CassetteOption {")
+	assert.Contains(t, code, "runtime.WithCassetteSensitiveFields(sensitiveData)")
+	assert.Regexp(t, `"number": \{\s+Type:\s+runtime\.MaskTypeFull,`, code)
+	assert.Regexp(t, `"ssn": \{\s+Type:\s+runtime\.MaskTypePartial,(.|\n)*?KeepSuffix: 4,`, code)
+	assert.NotContains(t, code, `"name": {`)
+	assert.Contains(t, code, "opts = append([]runtime.APIClientOption{runtime.WithErrorSensitiveData(sensitiveData)}, opts...)")
+
+	t.Run("no sensitive data", func(t *testing.T) {
+		codes, err := Generate([]byte(strings.ReplaceAll(spec, "x-sensitive-data", "x-other")), cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "CassetteSensitiveData")
+		assert.NotContains(t, codes.GetCombined(), "WithErrorSensitiveData")
+	})
+}
+
+func TestTypeMappingsInvalid(t *testing.T) {
+	cfg := Configuration{
+		PackageName:  "api",
+		TypeMappings: TypeMappings{"object": {Type: "Foo"}},
+	}
+
+	_, err := Generate([]byte(readTestdata(t, "type-mappings.yml")), cfg)
+	require.ErrorIs(t, err, ErrInvalidTypeMapping)
+}
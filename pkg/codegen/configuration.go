@@ -12,6 +12,7 @@ package codegen
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -24,6 +25,7 @@ import (
 // Filter is the configuration for filtering the paths and operations to be parsed.
 //
 // AdditionalImports defines any additional Go imports to add to the generated code.
+// TypeMappings maps OpenAPI type/format pairs to Go types, e.g. "string/decimal" to decimal.Decimal.
 // ErrorMapping is the configuration for mapping the OpenAPI error responses to Go types.
 //
 //	The key is the spec error type name
@@ -42,6 +44,7 @@ type Configuration struct {
 	Overlay  *OverlayOptions  `yaml:"overlay,omitempty"`
 
 	AdditionalImports []AdditionalImport `yaml:"additional-imports,omitempty"`
+	TypeMappings      TypeMappings       `yaml:"type-mappings,omitempty"`
 	ErrorMapping      map[string]string  `yaml:"error-mapping,omitempty"`
 	Client            *Client            `yaml:"client,omitempty"`
 
@@ -99,6 +102,12 @@ func (o Configuration) WithDefaults() Configuration {
 			if o.Generate.Handler.MultipartMaxMemory == 0 {
 				o.Generate.Handler.MultipartMaxMemory = 32
 			}
+			if o.Generate.Handler.MaxDecompressedBodySize == 0 {
+				o.Generate.Handler.MaxDecompressedBodySize = 32
+			}
+			if o.Generate.Handler.MaxIdempotentBodySize == 0 {
+				o.Generate.Handler.MaxIdempotentBodySize = 32
+			}
 		}
 	}
 
@@ -165,6 +174,9 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 			if other.Generate.AlwaysPrefixEnumValues {
 				o.Generate.AlwaysPrefixEnumValues = other.Generate.AlwaysPrefixEnumValues
 			}
+			if other.Generate.OpenEnums {
+				o.Generate.OpenEnums = other.Generate.OpenEnums
+			}
 			// Overwrite Validation options
 			if other.Generate.Validation.Skip {
 				o.Generate.Validation.Skip = other.Generate.Validation.Skip
@@ -187,6 +199,12 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 					if other.Generate.Handler.Kind != "" {
 						o.Generate.Handler.Kind = other.Generate.Handler.Kind
 					}
+					if other.Generate.Handler.GroupBy != "" {
+						o.Generate.Handler.GroupBy = other.Generate.Handler.GroupBy
+					}
+					if other.Generate.Handler.Strict {
+						o.Generate.Handler.Strict = other.Generate.Handler.Strict
+					}
 					if other.Generate.Handler.Validation.Request {
 						o.Generate.Handler.Validation.Request = other.Generate.Handler.Validation.Request
 					}
@@ -209,6 +227,18 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 			if other.Client.Timeout != 0 {
 				o.Client.Timeout = other.Client.Timeout
 			}
+			if other.Client.Fake {
+				o.Client.Fake = other.Client.Fake
+			}
+			if other.Client.RequestBuilders {
+				o.Client.RequestBuilders = other.Client.RequestBuilders
+			}
+			if other.Client.GroupBy != "" {
+				o.Client.GroupBy = other.Client.GroupBy
+			}
+			if other.Client.MultipleTags != "" {
+				o.Client.MultipleTags = other.Client.MultipleTags
+			}
 		}
 	}
 
@@ -222,6 +252,11 @@ func (o Configuration) OverwriteWith(other Configuration) Configuration {
 		o.AdditionalImports = other.AdditionalImports
 	}
 
+	// Overwrite TypeMappings
+	if len(other.TypeMappings) > 0 {
+		o.TypeMappings = other.TypeMappings
+	}
+
 	// Overwrite ErrorMapping
 	if len(other.ErrorMapping) > 0 {
 		o.ErrorMapping = other.ErrorMapping
@@ -245,15 +280,70 @@ type AdditionalImport struct {
 	Package string `yaml:"package"`
 }
 
+// TypeMapping describes the Go type used for an OpenAPI type/format pair.
+// Type is the Go type expression, e.g. "decimal.Decimal".
+// Import is the package providing the type, if it lives outside the generated package.
+type TypeMapping struct {
+	Type   string            `yaml:"type"`
+	Import *AdditionalImport `yaml:"import,omitempty"`
+}
+
+// GoImport returns the import needed for the mapped type, or nil if none is required.
+func (m TypeMapping) GoImport() *goImport {
+	if m.Import == nil || m.Import.Package == "" {
+		return nil
+	}
+	return &goImport{Name: m.Import.Alias, Path: m.Import.Package}
+}
+
+// TypeMappings maps OpenAPI type/format pairs to Go types.
+// Keys are either "type/format" (e.g. "string/decimal", "integer/int64")
+// or a bare "type" which applies to schemas of that type without a format.
+type TypeMappings map[string]TypeMapping
+
+// Lookup returns the mapping for the given OpenAPI type and format.
+func (m TypeMappings) Lookup(typ, format string) (TypeMapping, bool) {
+	if len(m) == 0 || typ == "" {
+		return TypeMapping{}, false
+	}
+	key := typ
+	if format != "" {
+		key += "/" + format
+	}
+	res, ok := m[key]
+	if !ok || res.Type == "" {
+		return TypeMapping{}, false
+	}
+	return res, true
+}
+
+// Validate returns an error if any of the mappings is invalid.
+func (m TypeMappings) Validate() error {
+	for key, mapping := range m {
+		typ, _, _ := strings.Cut(key, "/")
+		switch typ {
+		case "string", "integer", "number", "boolean":
+		default:
+			return fmt.Errorf("%w: %q: unsupported type %q", ErrInvalidTypeMapping, key, typ)
+		}
+		if mapping.Type == "" {
+			return fmt.Errorf("%w: %q: type is required", ErrInvalidTypeMapping, key)
+		}
+	}
+	return nil
+}
+
 // FilterConfig is the configuration for filtering the paths and operations to be parsed.
+// ExcludeDeprecated removes deprecated operations, and optional deprecated parameters and properties.
 type FilterConfig struct {
-	Include FilterParamsConfig `yaml:"include"`
-	Exclude FilterParamsConfig `yaml:"exclude"`
+	Include           FilterParamsConfig `yaml:"include"`
+	Exclude           FilterParamsConfig `yaml:"exclude"`
+	ExcludeDeprecated bool               `yaml:"exclude-deprecated"`
 }
 
 // IsEmpty returns true if the filter is empty.
 func (o FilterConfig) IsEmpty() bool {
-	return o.Include.IsEmpty() && o.Exclude.IsEmpty()
+	return o.Include.IsEmpty() && o.Exclude.IsEmpty() && !o.ExcludeDeprecated
 }
 
 // FilterParamsConfig is the configuration for filtering the paths to be parsed.
@@ -305,6 +395,16 @@ type GenerateOptions struct {
 	// AlwaysPrefixEnumValues specifies whether to always prefix enum values with the schema name. Defaults to true.
 	AlwaysPrefixEnumValues bool `yaml:"always-prefix-enum-values"`
 
+	// OpenEnums specifies whether enums accept values not listed in the spec.
+	// Unknown values are kept as-is and reported by IsKnown() instead of failing validation.
+	// Can be overridden per schema with the x-enum-open extension. Defaults to false.
+	OpenEnums bool `yaml:"open-enums"`
+
+	// Codecs lists the media types, such as application/xml, to generate typed bodies for.
+	// Patterns with * are supported, e.g. application/*+xml. Bodies are marshaled by the runtime codecs.
+	// Schemas of operations with XML media types also get xml struct tags, from the OpenAPI xml object.
+	Codecs []string `yaml:"codecs,omitempty"`
+
 	// Validation specifies options for Validate() method generation.
 	Validation ValidationOptions `yaml:"validation"`
 }
@@ -339,6 +439,61 @@ type OverlayOptions struct {
 type Client struct {
 	Name    string        `yaml:"name"`
 	Timeout time.Duration `yaml:"timeout"`
+
+	// Fake generates Fake<Name>, an in-memory implementation of the client interface for tests.
+	Fake bool `yaml:"fake"`
+
+	// RequestBuilders generates New<Op>Request builders and Parse<Op>Response parsers,
+	// to build requests without sending them and decode the responses.
+	RequestBuilders bool `yaml:"request-builders"`
+
+	// GroupBy splits the client into sub-clients, returned by the methods of the root client.
+	// With "tags", there is a sub-client per tag. Operations without tags stay on the root client.
+	GroupBy GroupBy `yaml:"group-by"`
+
+	// MultipleTags places the operations with several tags when grouping by tags.
+	// With "first", the default, they go to the sub-client of their first tag.
+	// With "all", they go to the sub-clients of all their tags.
+	MultipleTags ClientMultipleTags `yaml:"multiple-tags"`
+}
+
+// GroupBy specifies how the operations are split into sub-clients or services.
+type GroupBy string
+
+const (
+	GroupByNone GroupBy = ""
+	GroupByTags GroupBy = "tags"
+)
+
+// IsValid returns true if the group-by is a supported value.
+func (g GroupBy) IsValid() bool {
+	switch g {
+	case GroupByNone, GroupByTags:
+		return true
+	default:
+		return false
+	}
+}
+
+// ClientMultipleTags specifies the sub-clients of the operations with several tags.
+type ClientMultipleTags string
+
+const (
+	ClientMultipleTagsFirst ClientMultipleTags = "first"
+	ClientMultipleTagsAll   ClientMultipleTags = "all"
+)
+
+// Validate checks the client options.
+func (o Client) Validate() error {
+	if !o.GroupBy.IsValid() {
+		return fmt.Errorf("%w: %q", ErrGroupByUnsupported, o.GroupBy)
+	}
+	switch o.MultipleTags {
+	case "", ClientMultipleTagsFirst, ClientMultipleTagsAll:
+	default:
+		return fmt.Errorf("%w: %q", ErrClientMultipleTagsUnsupported, o.MultipleTags)
+	}
+	return nil
 }
 
 // HandlerKind specifies the router/framework to generate handler code for.
@@ -370,6 +525,16 @@ func (k HandlerKind) IsValid() bool {
 	}
 }
 
+// IsNetHTTP returns true if the generated NewRouter(svc) returns a net/http handler.
+func (k HandlerKind) IsNetHTTP() bool {
+	switch k {
+	case HandlerKindChi, HandlerKindGoZero, HandlerKindGorillaMux, HandlerKindKratos, HandlerKindStdHTTP:
+		return true
+	default:
+		return false
+	}
+}
+
 // HandlerOptions specifies options for handler/server code generation.
 type HandlerOptions struct {
 	// Name is the name of the service interface. Defaults to "Service".
@@ -382,6 +547,14 @@ type HandlerOptions struct {
 	// Validation specifies options for request/response validation in handlers.
 	Validation HandlerValidation `yaml:"validation"`
 
+	// GroupBy splits the service interface into one interface per group, e.g. per tag with "tags".
+	// Operations without tags go to the Default group. Defaults to no grouping.
+	GroupBy GroupBy `yaml:"group-by"`
+
+	// Strict makes the service methods return a <Op>ResponseObject, one of the <Op><Status>Response
+	// types of the documented responses, with typed body and headers. Defaults to false.
+	Strict bool `yaml:"strict"`
+
 	// ModelsPackageAlias is the package alias to prefix model types with.
 	// Used when models are generated separately (generate.models: false).
 	// Example: "types" will generate "types.User" instead of "User".
@@ -391,6 +564,14 @@ type HandlerOptions struct {
 	// Defaults to 32MB (matching Go stdlib). Files exceeding this are stored in temp files.
 	MultipartMaxMemory int `yaml:"multipart-max-memory"`
 
+	// MaxDecompressedBodySize is the maximum size in MB of a gzip or deflate encoded request body after decoding.
+	// Defaults to 32MB. Larger bodies are rejected to guard against decompression bombs.
+	MaxDecompressedBodySize int `yaml:"max-decompressed-body-size"`
+
+	// MaxIdempotentBodySize is the maximum size in MB of a request body fingerprinted for an x-idempotent operation.
+	// Defaults to 32MB. Larger bodies are rejected with 413 Request Entity Too Large.
+	MaxIdempotentBodySize int `yaml:"max-idempotent-body-size"`
+
 	// Output specifies output for scaffolded handler files (service.go, middleware.go).
 	// Falls back to root output if nil.
 	Output *ScaffoldOutput `yaml:"output"`
@@ -438,6 +619,9 @@ func (o HandlerOptions) Validate() error {
 	if !o.Kind.IsValid() {
 		return fmt.Errorf("%w: %q", ErrHandlerKindUnsupported, o.Kind)
 	}
+	if !o.GroupBy.IsValid() {
+		return fmt.Errorf("%w: %q", ErrGroupByUnsupported, o.GroupBy)
+	}
 	return nil
 }
 
@@ -310,6 +310,53 @@ func TestConfiguration_OverwriteWith(t *testing.T) {
 		result := userConfig.OverwriteWith(overrides)
 		assert.True(t, result.SkipPrune)
 	})
+
+	t.Run("other TypeMappings overwrites user TypeMappings", func(t *testing.T) {
+		userConfig := Configuration{
+			TypeMappings: TypeMappings{"string/decimal": {Type: "float64"}},
+		}
+		overrides := Configuration{
+			TypeMappings: TypeMappings{"integer/int64": {Type: "ID"}},
+		}
+
+		result := userConfig.OverwriteWith(overrides)
+		assert.Equal(t, TypeMappings{"integer/int64": {Type: "ID"}}, result.TypeMappings)
+	})
+}
+
+func TestTypeMappings_Lookup(t *testing.T) {
+	mappings := TypeMappings{
+		"string/decimal": {Type: "decimal.Decimal"},
+		"integer":        {Type: "ID"},
+	}
+
+	m, ok := mappings.Lookup("string", "decimal")
+	assert.True(t, ok)
+	assert.Equal(t, "decimal.Decimal", m.Type)
+
+	m, ok = mappings.Lookup("integer", "")
+	assert.True(t, ok)
+	assert.Equal(t, "ID", m.Type)
+
+	_, ok = mappings.Lookup("integer", "int64")
+	assert.False(t, ok)
+
+	_, ok = mappings.Lookup("string", "")
+	assert.False(t, ok)
+}
+
+func TestTypeMappings_Validate(t *testing.T) {
+	assert.NoError(t, TypeMappings{"string/decimal": {Type: "decimal.Decimal"}}.Validate())
+	assert.ErrorIs(t, TypeMappings{"object": {Type: "Foo"}}.Validate(), ErrInvalidTypeMapping)
+	assert.ErrorIs(t, TypeMappings{"string/decimal": {}}.Validate(), ErrInvalidTypeMapping)
+}
+
+func TestTypeMapping_GoImport(t *testing.T) {
+	assert.Nil(t, TypeMapping{Type: "ID"}.GoImport())
+	assert.Equal(t, &goImport{Name: "dec", Path: "github.com/shopspring/decimal"}, TypeMapping{
+		Type:   "dec.Decimal",
+		Import: &AdditionalImport{Alias: "dec", Package: "github.com/shopspring/decimal"},
+	}.GoImport())
 }
 
 // TestConfiguration_Merge tests backwards compatibility
@@ -0,0 +1,50 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecatedOperations(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client:  true,
+			Handler: &HandlerOptions{},
+		},
+	}
+
+	code := generateCode(t, readTestdata(t, "deprecation.yml"), cfg).GetCombined()
+
+	t.Run("doc comments", func(t *testing.T) {
+		assert.Contains(t, code, "// GetUser Get a user.\n//\n// Deprecated: Use getAccount instead.\nfunc (c *Client) GetUser(")
+		assert.Contains(t, code, "// Deprecated:\n\tFields *string")
+	})
+
+	t.Run("client observes deprecated parameters", func(t *testing.T) {
+		assert.Contains(t, code, "if options != nil && options.Query != nil && options.Query.Fields != nil {")
+		assert.Contains(t, code, "if options != nil && options.Query != nil && len(options.Query.Expand) > 0 {")
+		assert.Contains(t, code, "if options != nil && options.Header != nil && options.Header.XLegacyTenant != nil {")
+		assert.Contains(t, code, `Parameter:   "X-Request-Source",`)
+	})
+
+	t.Run("adapter sets headers", func(t *testing.T) {
+		assert.Equal(t, 1, strings.Count(code, `w.Header().Set("Deprecation",`))
+		assert.Equal(t, 1, strings.Count(code, `w.Header().Set("Sunset",`))
+	})
+}
@@ -27,6 +27,7 @@ var (
 	ErrMergingSchemasWithDifferentFormats        = errors.New("can not merge incompatible formats")
 	ErrMergingSchemasWithDifferentDiscriminators = errors.New("merging two schemas with discriminators is not supported")
 	ErrMergingSchemasWithAdditionalProperties    = errors.New("merging two schemas with additional properties, this is unhandled")
+	ErrMergingSchemasWithDifferentPropertyNames  = errors.New("merging two schemas with different propertyNames")
 	ErrAmbiguousDiscriminatorMapping             = errors.New("ambiguous discriminator.mapping: please replace inlined object with $ref")
 	ErrDiscriminatorNotAllMapped                 = errors.New("discriminator: not all schemas were mapped")
 	ErrEmptySchema                               = errors.New("empty schema")
@@ -34,4 +35,14 @@ var (
 	ErrHandlerKindRequired                       = errors.New("handler kind is required")
 	ErrHandlerKindUnsupported                    = errors.New("unsupported handler kind")
 	ErrServerHandlerPackageRequired              = errors.New("server handler-package is required when server generation is enabled")
+	ErrInvalidTypeMapping                        = errors.New("invalid type mapping")
+	ErrInvalidPattern                            = errors.New("invalid pattern")
+	ErrInvalidSunset                             = errors.New("invalid x-sunset date")
+	ErrInvalidDeprecatedAt                       = errors.New("invalid x-deprecated-at date")
+	ErrInvalidPagination                         = errors.New("invalid x-pagination")
+	ErrInvalidLongRunning                        = errors.New("invalid x-long-running")
+	ErrGroupByUnsupported                        = errors.New("unsupported group-by")
+	ErrClientMultipleTagsUnsupported             = errors.New("unsupported client multiple-tags")
+	ErrInvalidTimeout                            = errors.New("invalid x-timeout")
+	ErrOperationGroupCollision                   = errors.New("operation group name collision")
 )
@@ -12,7 +12,9 @@ package codegen
 
 import (
 	"fmt"
+	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/pb33f/libopenapi/orderedmap"
 	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
@@ -44,6 +46,36 @@ const (
 	extEnumNames         = "x-enum-names"
 	extDeprecationReason = "x-deprecated-reason"
 
+	// extEnumDescriptions documents enum constants, in the same order as the values.
+	extEnumDescriptions = "x-enum-descriptions"
+
+	// extSunset is the date after which a deprecated operation is removed, sent in the Sunset header.
+	extSunset = "x-sunset"
+
+	// extDeprecatedAt is the date an operation is deprecated from, sent in the Deprecation header.
+	extDeprecatedAt = "x-deprecated-at"
+
+	// extRetryable marks an operation as safe or unsafe to retry, regardless of its method.
+	extRetryable = "x-retryable"
+
+	// extIdempotent marks an operation as requiring an Idempotency-Key header.
+	extIdempotent = "x-idempotent"
+
+	// extXMLTags enables or disables xml struct tags for the schemas of an operation.
+	extXMLTags = "x-xml-tags"
+
+	// extPagination describes how to iterate over the pages of a list operation.
+	extPagination = "x-pagination"
+
+	// extLongRunning describes how to poll for the completion of a long-running operation.
+	extLongRunning = "x-long-running"
+
+	// extTimeout is the timeout of an operation, applied as a context deadline by the generated client.
+	extTimeout = "x-timeout"
+
+	// extEnumOpen allows enum values not listed in the spec, overriding the open-enums option.
+	extEnumOpen = "x-enum-open"
+
 	// extOapiCodegenOnlyHonourGoName explicitly enforces the generation of a
 	// field as the `x-go-name` extension describes it.
 	extOapiCodegenOnlyHonourGoName = "x-oapi-codegen-only-honour-go-name"
@@ -149,6 +181,66 @@ func extParseEnumVarNames(extPropValue any) ([]string, error) {
 	return strs, nil
 }
 
+// extParseSunset parses a date, an RFC 3339 timestamp or an HTTP-date and returns it as an HTTP-date.
+func extParseSunset(extPropValue any) (string, error) {
+	t, err := extParseDate(extPropValue, ErrInvalidSunset)
+	if err != nil {
+		return "", err
+	}
+	return t.UTC().Format(http.TimeFormat), nil
+}
+
+// extParseDeprecatedAt parses a date like extParseSunset and returns it as an RFC 9745 structured-field date,
+// the number of seconds since the Unix epoch prefixed with @, e.g. @1767225600.
+func extParseDeprecatedAt(extPropValue any) (string, error) {
+	t, err := extParseDate(extPropValue, ErrInvalidDeprecatedAt)
+	if err != nil {
+		return "", err
+	}
+	return "@" + strconv.FormatInt(t.Unix(), 10), nil
+}
+
+// extParseDate parses a date, an RFC 3339 timestamp or an HTTP-date, returning errInvalid for other values.
+func extParseDate(extPropValue any, errInvalid error) (time.Time, error) {
+	value, err := parseString(extPropValue)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, layout := range []string{time.DateOnly, time.RFC3339, http.TimeFormat} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: %q", errInvalid, value)
+}
+
+// extParseTimeout parses a Go duration string, e.g. "1m30s", or a number of seconds.
+func extParseTimeout(extPropValue any) (time.Duration, error) {
+	var timeout time.Duration
+	switch v := extPropValue.(type) {
+	case int:
+		timeout = time.Duration(v) * time.Second
+	case float64:
+		timeout = time.Duration(v * float64(time.Second))
+	case string:
+		if seconds, err := strconv.ParseFloat(v, 64); err == nil {
+			timeout = time.Duration(seconds * float64(time.Second))
+			break
+		}
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %w", ErrInvalidTimeout, err)
+		}
+		timeout = d
+	default:
+		return 0, fmt.Errorf("%w: expected duration or seconds, got %T", ErrInvalidTimeout, extPropValue)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("%w: must be positive, got %v", ErrInvalidTimeout, extPropValue)
+	}
+	return timeout, nil
+}
+
 func extractExtensions(schemaExtensions *orderedmap.Map[string, *yaml.Node]) map[string]any {
 	if schemaExtensions == nil || schemaExtensions.Len() == 0 {
 		return nil
@@ -13,6 +13,7 @@ package codegen
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -118,3 +119,136 @@ func Test_extParsePropGoTypeSkipOptionalPointer(t *testing.T) {
 		})
 	}
 }
+
+func Test_extParseSunset(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "date",
+			value: "2026-12-31",
+			want:  "Thu, 31 Dec 2026 00:00:00 GMT",
+		},
+		{
+			name:  "date-time",
+			value: "2026-12-31T10:00:00+02:00",
+			want:  "Thu, 31 Dec 2026 08:00:00 GMT",
+		},
+		{
+			name:  "http-date",
+			value: "Thu, 31 Dec 2026 08:00:00 GMT",
+			want:  "Thu, 31 Dec 2026 08:00:00 GMT",
+		},
+		{
+			name:    "invalid",
+			value:   "next year",
+			wantErr: ErrInvalidSunset,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extParseSunset(tt.value)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_extParseDeprecatedAt(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "date",
+			value: "2026-01-01",
+			want:  "@1767225600",
+		},
+		{
+			name:  "date-time",
+			value: "2026-01-01T02:00:00+02:00",
+			want:  "@1767225600",
+		},
+		{
+			name:  "http-date",
+			value: "Thu, 01 Jan 2026 00:00:00 GMT",
+			want:  "@1767225600",
+		},
+		{
+			name:    "invalid",
+			value:   "last year",
+			wantErr: ErrInvalidDeprecatedAt,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extParseDeprecatedAt(tt.value)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_extParseTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    time.Duration
+		wantErr error
+	}{
+		{
+			name:  "duration",
+			value: "1m30s",
+			want:  90 * time.Second,
+		},
+		{
+			name:  "seconds",
+			value: 45,
+			want:  45 * time.Second,
+		},
+		{
+			name:  "fractional seconds",
+			value: 0.5,
+			want:  500 * time.Millisecond,
+		},
+		{
+			name:  "seconds string",
+			value: "90",
+			want:  90 * time.Second,
+		},
+		{
+			name:    "invalid",
+			value:   "soon",
+			wantErr: ErrInvalidTimeout,
+		},
+		{
+			name:    "not positive",
+			value:   "0s",
+			wantErr: ErrInvalidTimeout,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extParseTimeout(tt.value)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
 	"go.yaml.in/yaml/v4"
@@ -29,7 +30,8 @@ func filterOutDocument(doc libopenapi.Document, cfg FilterConfig) (*v3high.Docum
 
 	removedOperations := filterOperations(&model.Model, cfg)
 	removedProperties := filterComponentSchemaProperties(&model.Model, cfg)
-	filtered := removedOperations || removedProperties
+	removedDeprecated := filterDeprecated(&model.Model, cfg)
+	filtered := removedOperations || removedProperties || removedDeprecated
 
 	// Don't reload yet - let the caller decide when to reload (after pruning if needed)
 	return &model.Model, filtered, nil
@@ -98,28 +100,164 @@ func filterOperations(model *v3high.Document, cfg FilterConfig) bool {
 
 			if remove {
 				removed = true
-				switch strings.ToLower(method) {
-				case "get":
-					pathItem.Get = nil
-				case "post":
-					pathItem.Post = nil
-				case "put":
-					pathItem.Put = nil
-				case "delete":
-					pathItem.Delete = nil
-				case "patch":
-					pathItem.Patch = nil
-				case "head":
-					pathItem.Head = nil
-				case "options":
-					pathItem.Options = nil
-				case "trace":
-					pathItem.Trace = nil
+				removeOperation(pathItem, method)
+			}
+		}
+	}
+
+	return removed
+}
+
+func removeOperation(pathItem *v3high.PathItem, method string) {
+	switch strings.ToLower(method) {
+	case "get":
+		pathItem.Get = nil
+	case "post":
+		pathItem.Post = nil
+	case "put":
+		pathItem.Put = nil
+	case "delete":
+		pathItem.Delete = nil
+	case "patch":
+		pathItem.Patch = nil
+	case "head":
+		pathItem.Head = nil
+	case "options":
+		pathItem.Options = nil
+	case "trace":
+		pathItem.Trace = nil
+	}
+}
+
+// filterDeprecated removes deprecated operations, optional deprecated parameters
+// and optional deprecated properties of component and inline schemas.
+func filterDeprecated(model *v3high.Document, cfg FilterConfig) bool {
+	if !cfg.ExcludeDeprecated {
+		return false
+	}
+
+	removed := false
+	visited := map[*base.Schema]bool{}
+
+	if model.Paths != nil && model.Paths.PathItems != nil {
+		for _, pathItem := range model.Paths.PathItems.FromOldest() {
+			if params, ok := withoutDeprecatedParameters(pathItem.Parameters); ok {
+				pathItem.Parameters = params
+				removed = true
+			}
+
+			for method, op := range pathItem.GetOperations().FromOldest() {
+				if op.Deprecated != nil && *op.Deprecated {
+					removeOperation(pathItem, method)
+					removed = true
+					continue
+				}
+
+				if params, ok := withoutDeprecatedParameters(op.Parameters); ok {
+					op.Parameters = params
+					removed = true
+				}
+
+				var contents []*orderedmap.Map[string, *v3high.MediaType]
+				if op.RequestBody != nil {
+					contents = append(contents, op.RequestBody.Content)
+				}
+				if op.Responses != nil {
+					if op.Responses.Default != nil {
+						contents = append(contents, op.Responses.Default.Content)
+					}
+					if op.Responses.Codes != nil {
+						for _, response := range op.Responses.Codes.FromOldest() {
+							contents = append(contents, response.Content)
+						}
+					}
+				}
+				for _, content := range contents {
+					if content == nil {
+						continue
+					}
+					for _, mediaType := range content.FromOldest() {
+						if mediaType.Schema != nil && !mediaType.Schema.IsReference() &&
+							removeDeprecatedProperties(mediaType.Schema.Schema(), visited) {
+							removed = true
+						}
+					}
 				}
 			}
 		}
 	}
 
+	if model.Components != nil && model.Components.Schemas != nil {
+		for _, schemaProxy := range model.Components.Schemas.FromOldest() {
+			if removeDeprecatedProperties(schemaProxy.Schema(), visited) {
+				removed = true
+			}
+		}
+	}
+
+	return removed
+}
+
+// withoutDeprecatedParameters returns the parameters without the optional deprecated ones.
+// The second value is true if any parameter was removed.
+func withoutDeprecatedParameters(params []*v3high.Parameter) ([]*v3high.Parameter, bool) {
+	res := make([]*v3high.Parameter, 0, len(params))
+	for _, param := range params {
+		required := param.Required != nil && *param.Required
+		if param.Deprecated && !required {
+			continue
+		}
+		res = append(res, param)
+	}
+	return res, len(res) != len(params)
+}
+
+// removeDeprecatedProperties removes optional deprecated properties of the schema
+// and of its inline subschemas. Referenced schemas are handled as components.
+func removeDeprecatedProperties(schema *base.Schema, visited map[*base.Schema]bool) bool {
+	if schema == nil || visited[schema] {
+		return false
+	}
+	visited[schema] = true
+
+	removed := false
+	var subschemas []*base.SchemaProxy
+
+	if schema.Properties != nil {
+		var deprecated []string
+		for propName, propProxy := range schema.Properties.FromOldest() {
+			prop := propProxy.Schema()
+			if prop != nil && prop.Deprecated != nil && *prop.Deprecated && !slices.Contains(schema.Required, propName) {
+				deprecated = append(deprecated, propName)
+				continue
+			}
+			subschemas = append(subschemas, propProxy)
+		}
+		for _, propName := range deprecated {
+			schema.Properties.Delete(propName)
+			removed = true
+		}
+	}
+
+	subschemas = append(subschemas, schema.AllOf...)
+	subschemas = append(subschemas, schema.AnyOf...)
+	subschemas = append(subschemas, schema.OneOf...)
+	if schema.Items != nil && schema.Items.IsA() {
+		subschemas = append(subschemas, schema.Items.A)
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.IsA() {
+		subschemas = append(subschemas, schema.AdditionalProperties.A)
+	}
+
+	for _, proxy := range subschemas {
+		if proxy == nil || proxy.IsReference() {
+			continue
+		}
+		if removeDeprecatedProperties(proxy.Schema(), visited) {
+			removed = true
+		}
+	}
+
 	return removed
 }
 
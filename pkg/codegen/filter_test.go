@@ -344,3 +344,38 @@ func TestFilterOperationsByPath(t *testing.T) {
 		assert.Contains(t, combined, `"/enum"`)
 	})
 }
+
+func TestFilterDeprecated(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Filter: FilterConfig{
+			ExcludeDeprecated: true,
+		},
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	codes, err := Generate([]byte(readTestdata(t, "deprecation.yml")), cfg)
+	require.NoError(t, err)
+	code := codes.GetCombined()
+
+	// operations and the schemas only they use
+	assert.NotContains(t, code, "GetUser")
+	assert.NotContains(t, code, "LegacyUser")
+	assert.Contains(t, code, "func (c *Client) GetAccount(")
+
+	// optional parameters
+	assert.NotContains(t, code, "GetAccountQuery")
+	assert.NotContains(t, code, "XLegacyTenant")
+	assert.Contains(t, code, "XRequestSource string")
+
+	// optional properties, including inline schemas
+	assert.NotContains(t, code, "LegacyID")
+	assert.NotContains(t, code, "OldTheme")
+	assert.Contains(t, code, "OwnerID  string")
+	assert.Contains(t, code, "Theme *string")
+}
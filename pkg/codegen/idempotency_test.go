@@ -0,0 +1,58 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotentOperations(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client:  true,
+			Handler: &HandlerOptions{},
+		},
+	}
+
+	code := generateCode(t, readTestdata(t, "idempotent.yml"), cfg).GetCombined()
+
+	t.Run("client sets idempotent", func(t *testing.T) {
+		assert.Regexp(t, `RequestURL:\s+c\.apiClient\.GetBaseURL\(\) \+ "/payments",\s+Method:\s+"POST",\s+Idempotent:\s+true,`, code)
+		assert.Regexp(t, `Method:\s+"POST",\s+Retryable:\s+runtime\.Ptr\(false\),\s+Idempotent:\s+true,`, code)
+		assert.Equal(t, 2, strings.Count(code, "Idempotent: "))
+	})
+
+	t.Run("adapter replays stored responses", func(t *testing.T) {
+		assert.Contains(t, code, `runtime.StartIdempotent(w, r, a.idempotencyStore, "CreatePayment", 32<<20)`)
+		assert.Contains(t, code, `runtime.StartIdempotent(w, r, a.idempotencyStore, "CreateRefund", 32<<20)`)
+		assert.Equal(t, 2, strings.Count(code, "runtime.StartIdempotent("))
+	})
+
+	t.Run("max body size", func(t *testing.T) {
+		cfg := cfg
+		cfg.Generate = &GenerateOptions{Handler: &HandlerOptions{MaxIdempotentBodySize: 1}}
+		code := generateCode(t, readTestdata(t, "idempotent.yml"), cfg).GetCombined()
+		assert.Contains(t, code, `runtime.StartIdempotent(w, r, a.idempotencyStore, "CreatePayment", 1<<20)`)
+	})
+
+	t.Run("no store without idempotent operations", func(t *testing.T) {
+		code := generateCode(t, strings.ReplaceAll(readTestdata(t, "idempotent.yml"), "x-idempotent: true", "x-idempotent: false"), cfg).GetCombined()
+		assert.NotContains(t, code, "IdempotencyStore")
+		assert.NotContains(t, code, "idempotencyStore")
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInProcessClient(t *testing.T) {
+	newConfig := func(kind HandlerKind) Configuration {
+		return Configuration{
+			PackageName: "api",
+			Output: &Output{
+				UseSingleFile: true,
+			},
+			Generate: &GenerateOptions{
+				Client:  true,
+				Handler: &HandlerOptions{Kind: kind, Name: "UserService"},
+			},
+			Client: &Client{
+				Name: "UsersClient",
+			},
+		}
+	}
+
+	for _, kind := range []HandlerKind{HandlerKindStdHTTP, HandlerKindChi, HandlerKindGorillaMux, HandlerKindGoZero, HandlerKindKratos} {
+		t.Run(string(kind), func(t *testing.T) {
+			code := generateCode(t, readTestdata(t, "operation-info.yml"), newConfig(kind)).GetCombined()
+
+			assert.Contains(t, code, "func NewInProcessUsersClient(svc UserServiceInterface, opts ...runtime.APIClientOption) (*UsersClient, error) {")
+			assert.Contains(t, code, "runtime.WithHTTPClient(runtime.NewHandlerDoer(NewRouter(svc)))")
+		})
+	}
+
+	t.Run("router without net/http handler", func(t *testing.T) {
+		codes, err := Generate([]byte(readTestdata(t, "operation-info.yml")), newConfig(HandlerKindEcho))
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "NewInProcessUsersClient")
+	})
+
+	t.Run("without handler", func(t *testing.T) {
+		cfg := newConfig(HandlerKindStdHTTP)
+		cfg.Generate.Handler = nil
+		codes, err := Generate([]byte(readTestdata(t, "operation-info.yml")), cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, codes.GetCombined(), "NewInProcessUsersClient")
+	})
+}
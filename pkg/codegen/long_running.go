@@ -0,0 +1,155 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// LongRunningExtension is the x-long-running extension of an operation.
+// StatusOperation is the operationId of the operation returning the status resource.
+// StatusField is the status resource property with the state, Success and Failure are its terminal values.
+// Result is the status resource property with the final result, empty for the whole status resource.
+type LongRunningExtension struct {
+	StatusOperation string
+	StatusField     string
+	Success         []string
+	Failure         []string
+	Result          string
+}
+
+// LongRunningDefinition describes how the generated client waits for a long-running operation.
+// StatusOp is the operation polled for the status, at the URL from the Operation-Location or Location header.
+// ResultField is the Go field with the final result, empty if it's the whole status resource.
+type LongRunningDefinition struct {
+	StatusOp    *OperationDefinition
+	StatusField string
+	StatusPtr   bool
+	Success     []string
+	Failure     []string
+	ResultType  string
+	ResultField string
+	ResultPtr   bool
+}
+
+// ResultExpr returns the expression for the pointer to the result of the given status resource.
+func (l LongRunningDefinition) ResultExpr(status string) string {
+	switch {
+	case l.ResultField == "":
+		return status
+	case l.ResultPtr:
+		return status + "." + l.ResultField
+	default:
+		return "&" + status + "." + l.ResultField
+	}
+}
+
+// extParseLongRunning parses the x-long-running extension value, applying the default status field and states.
+func extParseLongRunning(extPropValue any) (*LongRunningExtension, error) {
+	m, ok := extPropValue.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: must be an object, got %T", ErrInvalidLongRunning, extPropValue)
+	}
+
+	ext := &LongRunningExtension{}
+	for key, target := range map[string]*string{
+		"status-operation": &ext.StatusOperation,
+		"status-field":     &ext.StatusField,
+		"result":           &ext.Result,
+	} {
+		if value, ok := m[key]; ok {
+			str, err := parseString(value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %w", ErrInvalidLongRunning, key, err)
+			}
+			*target = str
+		}
+	}
+	for key, target := range map[string]*[]string{
+		"success": &ext.Success,
+		"failure": &ext.Failure,
+	} {
+		if value, ok := m[key]; ok {
+			values, err := extParseEnumVarNames(value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %w", ErrInvalidLongRunning, key, err)
+			}
+			*target = values
+		}
+	}
+
+	if ext.StatusOperation == "" {
+		return nil, fmt.Errorf("%w: status-operation is required", ErrInvalidLongRunning)
+	}
+	ext.StatusField = cmp.Or(ext.StatusField, "status")
+	if len(ext.Success) == 0 {
+		ext.Success = []string{"succeeded"}
+	}
+	if _, ok := m["failure"]; !ok {
+		ext.Failure = []string{"failed", "canceled"}
+	}
+	return ext, nil
+}
+
+// resolveLongRunning resolves the x-long-running extension of the operation
+// against the status operation and its success response.
+func resolveLongRunning(ext *LongRunningExtension, operations []OperationDefinition, typeDefs []TypeDefinition) (*LongRunningDefinition, error) {
+	statusID, err := createOperationID("", "", ext.StatusOperation)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidLongRunning, err)
+	}
+	idx := slices.IndexFunc(operations, func(op OperationDefinition) bool {
+		return op.ID == statusID
+	})
+	if idx < 0 {
+		return nil, fmt.Errorf("%w: status operation %q not found", ErrInvalidLongRunning, ext.StatusOperation)
+	}
+	statusOp := operations[idx]
+
+	success := statusOp.Response.Success
+	if success == nil || success.IsRaw || statusOp.Response.SuccessStatusCode == 204 {
+		return nil, fmt.Errorf("%w: status operation must have a JSON success response", ErrInvalidLongRunning)
+	}
+
+	res := &LongRunningDefinition{
+		StatusOp:   &statusOp,
+		Success:    ext.Success,
+		Failure:    ext.Failure,
+		ResultType: success.ResponseName,
+	}
+
+	schema := resolveSchemaByName(success.Schema, typeDefs)
+	prop, ok := schema.propertyByJSONName(ext.StatusField)
+	if !ok {
+		return nil, fmt.Errorf("%w: status property %q not found", ErrInvalidLongRunning, ext.StatusField)
+	}
+	if statusSchema := prop.Schema.OpenAPISchema; statusSchema != nil && !slices.Contains(statusSchema.Type, "string") {
+		return nil, fmt.Errorf("%w: status property %q must be a string", ErrInvalidLongRunning, ext.StatusField)
+	}
+	res.StatusField = prop.GoName
+	res.StatusPtr = strings.HasPrefix(prop.GoTypeDef(), "*")
+
+	if ext.Result != "" {
+		prop, ok := schema.propertyByJSONName(ext.Result)
+		if !ok {
+			return nil, fmt.Errorf("%w: result property %q not found", ErrInvalidLongRunning, ext.Result)
+		}
+		typeDef := prop.GoTypeDef()
+		res.ResultField = prop.GoName
+		res.ResultType = strings.TrimPrefix(typeDef, "*")
+		res.ResultPtr = strings.HasPrefix(typeDef, "*")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLongRunning(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{
+			Fake: true,
+		},
+	}
+
+	code := generateCode(t, readTestdata(t, "long-running.yml"), cfg).GetCombined()
+
+	t.Run("result property", func(t *testing.T) {
+		assert.Contains(t, code, "CreateReportAndWait(ctx context.Context, options *CreateReportRequestOptions, pollOpts *runtime.PollOptions, reqEditors ...runtime.RequestEditorFn) (*Report, error)")
+		assert.Contains(t, code, "return new(struct{}), nil")
+		assert.Contains(t, code, "if _, err := c.CreateReport(runtime.WithCapturedResponse(ctx, &captured), options, reqEditors...); err != nil {")
+		assert.Contains(t, code, "location := runtime.OperationLocation(resp.Headers, captured.Request.URL)")
+		assert.Contains(t, code, `ID:     "GetReportOperation",`)
+		assert.Contains(t, code, "state := string(status.Status)")
+		assert.Regexp(t, `case "succeeded":\s+return resp, status\.Report, true, nil`, code)
+		assert.Regexp(t, `case "failed", "canceled":\s+return resp, nil, true, &runtime\.OperationFailedError\{OperationID: "CreateReport", State: state\}`, code)
+	})
+
+	t.Run("status resource", func(t *testing.T) {
+		assert.Contains(t, code, "StartExportAndWait(ctx context.Context, pollOpts *runtime.PollOptions, reqEditors ...runtime.RequestEditorFn) (*GetExportStatusResponse, error)")
+		assert.Regexp(t, `if status\.State == nil \{\s+return resp, nil, false, nil\s+\}\s+state := string\(\*status\.State\)`, code)
+		assert.Regexp(t, `case "done":\s+return resp, status, true, nil\s+\}`, code)
+		assert.NotContains(t, code, `OperationID: "StartExport"`)
+	})
+
+	t.Run("status timeout per poll", func(t *testing.T) {
+		assert.Regexp(t, `ID:\s+"GetExportStatus",\s+Method:\s+"GET",\s+Path:\s+"/exports/\{id\}",\s+Timeout: 2 \* time\.Second,`, code)
+		assert.Equal(t, 2, strings.Count(code, "Timeout: 2 * time.Second,"))
+	})
+
+	t.Run("fake", func(t *testing.T) {
+		assert.Contains(t, code, "func (f *FakeClient) CreateReportAndWait(")
+		assert.Regexp(t, `CreateReportAndWaitResponse\s+\*Report`, code)
+	})
+}
+
+func TestLongRunning_invalid(t *testing.T) {
+	spec := func(longRunning string) string {
+		return `
+openapi: 3.0.3
+info:
+  title: Long-running operations
+  version: 1.0.0
+paths:
+  /reports:
+    post:
+      operationId: createReport
+      x-long-running: ` + longRunning + `
+      responses:
+        "202":
+          description: Accepted
+  /ping:
+    get:
+      operationId: ping
+      responses:
+        "204":
+          description: No content
+  /operations/{id}:
+    get:
+      operationId: getOperation
+      parameters:
+        - {name: id, in: path, required: true, schema: {type: string}}
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  status:
+                    type: string
+                  progress:
+                    type: integer
+`
+	}
+
+	tests := []struct {
+		name        string
+		longRunning string
+		err         string
+	}{
+		{"not an object", `getOperation`, "must be an object"},
+		{"missing status operation", `{status-field: status}`, "status-operation is required"},
+		{"invalid states", `{status-operation: getOperation, success: done}`, "success"},
+		{"unknown status operation", `{status-operation: getStatus}`, `status operation "getStatus" not found`},
+		{"status operation without response", `{status-operation: ping}`, "must have a JSON success response"},
+		{"missing status field", `{status-operation: getOperation, status-field: state}`, `status property "state" not found`},
+		{"status not a string", `{status-operation: getOperation, status-field: progress}`, `status property "progress" must be a string`},
+		{"missing result", `{status-operation: getOperation, result: report}`, `result property "report" not found`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Generate([]byte(spec(tt.longRunning)), Configuration{
+				PackageName: "api",
+				Generate:    &GenerateOptions{Client: true},
+			})
+			require.ErrorIs(t, err, ErrInvalidLongRunning)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// OperationGroupDefinition is a group of operations with a tag, generated as a sub-client with client.group-by: tags
+// or as a service with generate.handler.group-by: tags.
+// Name is the Go name of the group, e.g. Orders.
+// Tags are the tags of the operations, more than one if their names collide in Go, none for the untagged operations.
+type OperationGroupDefinition struct {
+	Name       string
+	Tags       []string
+	Operations []OperationDefinition
+}
+
+// TypeName returns the name of the group type with the given suffix, e.g. OrdersClient.
+func (g OperationGroupDefinition) TypeName(suffix string) string {
+	return g.Name + suffix
+}
+
+// Description describes the operations of the group for doc comments, e.g. "tagged orders".
+func (g OperationGroupDefinition) Description() string {
+	if len(g.Tags) == 0 {
+		return "without tags"
+	}
+	return "tagged " + strings.Join(g.Tags, ", ")
+}
+
+// tagGroupName returns the group name of a tag.
+func tagGroupName(tag string) string {
+	return schemaNameToTypeName(tag)
+}
+
+// groupOperationsByTag groups the operations by tag, sorted by group name.
+// With allTags, an operation is in the groups of all its tags, otherwise in the group of its first tag.
+// Operations without tags are in the untagged group, or in none if untagged is empty.
+func groupOperationsByTag(operations []OperationDefinition, allTags bool, untagged string) []OperationGroupDefinition {
+	groups := map[string]*OperationGroupDefinition{}
+	group := func(name string) *OperationGroupDefinition {
+		g, ok := groups[name]
+		if !ok {
+			g = &OperationGroupDefinition{Name: name}
+			groups[name] = g
+		}
+		return g
+	}
+
+	for _, op := range operations {
+		if len(op.Tags) == 0 {
+			if untagged != "" {
+				g := group(untagged)
+				g.Operations = append(g.Operations, op)
+			}
+			continue
+		}
+		tags := op.Tags
+		if !allTags {
+			tags = tags[:1]
+		}
+
+		var names []string
+		for _, tag := range tags {
+			name := tagGroupName(tag)
+			g := group(name)
+			if !slices.Contains(g.Tags, tag) {
+				g.Tags = append(g.Tags, tag)
+			}
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+				g.Operations = append(g.Operations, op)
+			}
+		}
+	}
+
+	res := make([]OperationGroupDefinition, 0, len(groups))
+	for _, g := range groups {
+		res = append(res, *g)
+	}
+	slices.SortFunc(res, func(a, b OperationGroupDefinition) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return res
+}
+
+// checkClientGroups returns an error if the accessor of a sub-client has the name of a method of the root client,
+// or of the fake which implements the operations of all sub-clients, or if a sub-client type has the name of a type.
+func checkClientGroups(groups []OperationGroupDefinition, operations []OperationDefinition, typeDefs []TypeDefinition, client *Client) error {
+	members := map[string]string{}
+	for _, op := range operations {
+		if op.ClientGroup != "" && !client.Fake {
+			continue
+		}
+		for _, name := range clientMemberNames(op, client.Fake) {
+			members[name] = op.ID
+		}
+	}
+
+	types := typeDefinitionNames(typeDefs)
+	for _, g := range groups {
+		if opID, ok := members[g.Name]; ok {
+			return fmt.Errorf("%w: accessor %s of the sub-client %s collides with operation %s",
+				ErrOperationGroupCollision, g.Name, g.Description(), opID)
+		}
+		for _, typeName := range []string{g.TypeName(client.Name), g.TypeName(client.Name) + "Interface"} {
+			if types[typeName] {
+				return fmt.Errorf("%w: type %s of the sub-client %s collides with a schema type",
+					ErrOperationGroupCollision, typeName, g.Description())
+			}
+		}
+	}
+	return nil
+}
+
+// clientMemberNames returns the names of the methods generated for the operation,
+// with the fields and methods of the fake if fake is set.
+func clientMemberNames(op OperationDefinition, fake bool) []string {
+	res := []string{op.ID}
+	if fake {
+		res = append(res, op.ID+"Func", op.ID+"Response", op.ID+"Err", op.ID+"Calls")
+	}
+	if op.Pagination != nil {
+		res = append(res, op.ID+"All")
+		if fake {
+			res = append(res, op.ID+"AllFunc")
+		}
+	}
+	if op.LongRunning != nil {
+		res = append(res, op.ID+"AndWait")
+		if fake {
+			res = append(res, op.ID+"AndWaitFunc", op.ID+"AndWaitResponse")
+		}
+	}
+	return res
+}
+
+// checkServiceGroups returns an error if the field of a service in the aggregate has the name of an operation method,
+// or if a type generated for the services has the name of a type.
+func checkServiceGroups(groups []OperationGroupDefinition, operations []OperationDefinition, typeDefs []TypeDefinition, serviceName string) error {
+	types := typeDefinitionNames(typeDefs)
+	if types[serviceName+"s"] {
+		return fmt.Errorf("%w: type %ss collides with a schema type", ErrOperationGroupCollision, serviceName)
+	}
+
+	for _, g := range groups {
+		for _, op := range operations {
+			if op.ID == g.Name {
+				return fmt.Errorf("%w: field %s of %ss for the service %s collides with operation %s",
+					ErrOperationGroupCollision, g.Name, serviceName, g.Description(), op.ID)
+			}
+		}
+		groupService := g.TypeName(serviceName)
+		for _, typeName := range []string{groupService, groupService + "Interface", "Unimplemented" + groupService} {
+			if types[typeName] {
+				return fmt.Errorf("%w: type %s of the service %s collides with a schema type",
+					ErrOperationGroupCollision, typeName, g.Description())
+			}
+		}
+	}
+	return nil
+}
+
+// typeDefinitionNames returns the names of the type definitions, with their nested types.
+func typeDefinitionNames(typeDefs []TypeDefinition) map[string]bool {
+	res := map[string]bool{}
+	for _, td := range extractAllTypeDefinitions(typeDefs) {
+		res[td.Name] = true
+	}
+	return res
+}
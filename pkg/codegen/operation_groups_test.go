@@ -0,0 +1,232 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupOperationsByTag(t *testing.T) {
+	newOperations := func() []OperationDefinition {
+		return []OperationDefinition{
+			{ID: "ListUsers", Tags: []string{"users"}},
+			{ID: "ListPets", Tags: []string{"pet-store"}},
+			{ID: "GetPet", Tags: []string{"petStore"}},
+			{ID: "ListUserPets", Tags: []string{"users", "pet-store"}},
+			{ID: "Ping"},
+		}
+	}
+	opIDs := func(ops []OperationDefinition) []string {
+		var res []string
+		for _, op := range ops {
+			res = append(res, op.ID)
+		}
+		return res
+	}
+
+	t.Run("first tag", func(t *testing.T) {
+		groups := groupOperationsByTag(newOperations(), false, "")
+		require.Len(t, groups, 2)
+
+		assert.Equal(t, "PetStore", groups[0].Name)
+		assert.Equal(t, []string{"pet-store", "petStore"}, groups[0].Tags)
+		assert.Equal(t, []string{"ListPets", "GetPet"}, opIDs(groups[0].Operations))
+		assert.Equal(t, "PetStoreClient", groups[0].TypeName("Client"))
+		assert.Equal(t, "tagged pet-store, petStore", groups[0].Description())
+
+		assert.Equal(t, "Users", groups[1].Name)
+		assert.Equal(t, []string{"ListUsers", "ListUserPets"}, opIDs(groups[1].Operations))
+	})
+
+	t.Run("all tags", func(t *testing.T) {
+		groups := groupOperationsByTag(newOperations(), true, "")
+		require.Len(t, groups, 2)
+
+		assert.Equal(t, []string{"ListPets", "GetPet", "ListUserPets"}, opIDs(groups[0].Operations))
+		assert.Equal(t, []string{"ListUsers", "ListUserPets"}, opIDs(groups[1].Operations))
+	})
+
+	t.Run("untagged group", func(t *testing.T) {
+		groups := groupOperationsByTag(newOperations(), false, "Default")
+		require.Len(t, groups, 3)
+
+		assert.Equal(t, "Default", groups[0].Name)
+		assert.Empty(t, groups[0].Tags)
+		assert.Equal(t, "without tags", groups[0].Description())
+		assert.Equal(t, []string{"Ping"}, opIDs(groups[0].Operations))
+	})
+}
+
+func TestGroupByTags(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client:    true,
+			MCPServer: &MCPServerOptions{},
+		},
+		Client: &Client{
+			GroupBy: GroupByTags,
+			Fake:    true,
+		},
+	}
+
+	t.Run("first tag", func(t *testing.T) {
+		code := generateCode(t, readTestdata(t, "client-groups.yml"), cfg).GetCombined()
+
+		assert.Contains(t, code, "Orders() OrdersClientInterface")
+		assert.Contains(t, code, "Users() UsersClientInterface")
+		assert.Regexp(t, `orders:\s+&OrdersClient\{apiClient: apiClient\},`, code)
+		assert.Contains(t, code, "return NewClient(apiClient), nil")
+
+		assert.Contains(t, code, "func (c *Client) Ping(ctx context.Context")
+		assert.Contains(t, code, "func (c *OrdersClient) ListOrders(ctx context.Context")
+		assert.Contains(t, code, "func (c *OrdersClient) ListOrdersAll(ctx context.Context")
+		assert.Contains(t, code, "func (c *OrdersClient) GetOrder(ctx context.Context")
+		assert.Contains(t, code, "func (c *UsersClient) ListUserOrders(ctx context.Context")
+		assert.NotContains(t, code, "func (c *OrdersClient) ListUserOrders(")
+
+		assert.Contains(t, code, "var _ OrdersClientInterface = (*OrdersClient)(nil)")
+		assert.Contains(t, code, "func (f *FakeClient) Orders() OrdersClientInterface {")
+		assert.Contains(t, code, "t.client.Orders().GetOrder(ctx, opts)")
+		assert.Contains(t, code, "t.client.Ping(ctx)")
+	})
+
+	t.Run("all tags", func(t *testing.T) {
+		cfg := cfg
+		cfg.Client = &Client{GroupBy: GroupByTags, MultipleTags: ClientMultipleTagsAll}
+		code := generateCode(t, readTestdata(t, "client-groups.yml"), cfg).GetCombined()
+
+		assert.Contains(t, code, "func (c *UsersClient) ListUserOrders(ctx context.Context")
+		assert.Contains(t, code, "func (c *OrdersClient) ListUserOrders(ctx context.Context")
+		assert.Contains(t, code, "t.client.Users().ListUserOrders(ctx, opts)")
+	})
+
+	t.Run("accessor collides with untagged operation", func(t *testing.T) {
+		spec := strings.ReplaceAll(readTestdata(t, "client-groups.yml"), "operationId: ping", "operationId: orders")
+		_, err := Generate([]byte(spec), cfg)
+		assert.ErrorIs(t, err, ErrOperationGroupCollision)
+		assert.ErrorContains(t, err, "accessor Orders of the sub-client tagged orders collides with operation Orders")
+	})
+
+	t.Run("accessor collides with fake method", func(t *testing.T) {
+		spec := strings.ReplaceAll(readTestdata(t, "client-groups.yml"), "operationId: getOrder", "operationId: users")
+		_, err := Generate([]byte(spec), cfg)
+		assert.ErrorIs(t, err, ErrOperationGroupCollision)
+
+		cfg := cfg
+		cfg.Client = &Client{GroupBy: GroupByTags}
+		_, err = Generate([]byte(spec), cfg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("type collides with schema", func(t *testing.T) {
+		spec := readTestdata(t, "client-groups.yml")
+		spec = strings.ReplaceAll(spec, `schemas/Order"`, `schemas/UsersClient"`)
+		spec = strings.ReplaceAll(spec, "    Order:", "    UsersClient:")
+		_, err := Generate([]byte(spec), cfg)
+		assert.ErrorIs(t, err, ErrOperationGroupCollision)
+		assert.ErrorContains(t, err, "type UsersClient of the sub-client tagged users collides with a schema type")
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		cfg := cfg
+		cfg.Client = &Client{GroupBy: "paths"}
+		_, err := Generate([]byte(readTestdata(t, "client-groups.yml")), cfg)
+		assert.ErrorIs(t, err, ErrGroupByUnsupported)
+	})
+}
+
+func TestServiceGroupByTags(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Handler: &HandlerOptions{GroupBy: GroupByTags},
+		},
+	}
+
+	codes := generateCode(t, readTestdata(t, "client-groups.yml"), cfg)
+	code := codes.GetCombined()
+
+	t.Run("service interfaces", func(t *testing.T) {
+		assert.Regexp(t, `type ServiceInterface interface \{\s+DefaultServiceInterface\s+OrdersServiceInterface\s+UsersServiceInterface\s+\}`, code)
+		assert.Contains(t, code, "// OrdersServiceInterface defines the service interface for the operations tagged orders.")
+		assert.Contains(t, code, "// DefaultServiceInterface defines the service interface for the operations without tags.")
+		assert.Contains(t, code, "func (UnimplementedUsersService) ListUserOrders(ctx context.Context, opts *ListUserOrdersServiceRequestOptions) (*ListUserOrdersResponseData, error) {")
+		assert.Contains(t, code, "func (UnimplementedDefaultService) Ping(ctx context.Context) (*PingResponseData, error) {")
+	})
+
+	t.Run("services fall back to unimplemented", func(t *testing.T) {
+		assert.Contains(t, code, "var _ ServiceInterface = (*Services)(nil)")
+		assert.Contains(t, code, `if s.Orders == nil {
+		return UnimplementedOrdersService{}.GetOrder(ctx, opts)
+	}
+	return s.Orders.GetOrder(ctx, opts)`)
+	})
+
+	t.Run("adapter answers not implemented", func(t *testing.T) {
+		assert.Contains(t, code, "OapiErrorKindNotImplemented")
+		assert.Contains(t, code, "code = http.StatusNotImplemented")
+	})
+
+	t.Run("scaffolds a service per tag", func(t *testing.T) {
+		assert.Contains(t, codes["scaffold:service"], "return &Services{\n\t\tDefault: NewDefaultService(),\n\t\tOrders:  NewOrdersService(),\n\t\tUsers:   NewUsersService(),\n\t}")
+		assert.Contains(t, codes["scaffold:orders_service"], "var _ OrdersServiceInterface = (*OrdersService)(nil)")
+		assert.Contains(t, codes["scaffold:orders_service"], "func (o *OrdersService) GetOrder(ctx context.Context")
+		assert.NotContains(t, codes["scaffold:orders_service"], "ListUserOrders")
+		assert.Contains(t, codes["scaffold:users_service"], "func (u *UsersService) ListUserOrders(ctx context.Context")
+		assert.Contains(t, codes["scaffold:default_service"], "func (d *DefaultService) Ping(ctx context.Context) (*PingResponseData, error) {")
+	})
+
+	t.Run("service field collides with operation", func(t *testing.T) {
+		spec := strings.ReplaceAll(readTestdata(t, "client-groups.yml"), "operationId: ping", "operationId: users")
+		_, err := Generate([]byte(spec), cfg)
+		assert.ErrorIs(t, err, ErrOperationGroupCollision)
+		assert.ErrorContains(t, err, "field Users of Services for the service tagged users collides with operation Users")
+	})
+
+	t.Run("types collide with schema", func(t *testing.T) {
+		for _, name := range []string{"Services", "UnimplementedOrdersService", "DefaultServiceInterface"} {
+			spec := readTestdata(t, "client-groups.yml")
+			spec = strings.ReplaceAll(spec, `schemas/Order"`, `schemas/`+name+`"`)
+			spec = strings.ReplaceAll(spec, "    Order:", "    "+name+":")
+			_, err := Generate([]byte(spec), cfg)
+			assert.ErrorIs(t, err, ErrOperationGroupCollision, name)
+			assert.ErrorContains(t, err, "type "+name+" ", name)
+		}
+	})
+
+	t.Run("ungrouped", func(t *testing.T) {
+		cfg := cfg
+		cfg.Generate = &GenerateOptions{Handler: &HandlerOptions{}}
+		codes, err := Generate([]byte(readTestdata(t, "client-groups.yml")), cfg)
+		require.NoError(t, err)
+
+		assert.NotContains(t, codes.GetCombined(), "OapiErrorKindNotImplemented")
+		assert.NotContains(t, codes, "scaffold:orders_service")
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		cfg := cfg
+		cfg.Generate = &GenerateOptions{Handler: &HandlerOptions{GroupBy: "paths"}}
+		_, err := Generate([]byte(readTestdata(t, "client-groups.yml")), cfg)
+		assert.ErrorIs(t, err, ErrGroupByUnsupported)
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationInfo(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	code := generateCode(t, readTestdata(t, "operation-info.yml"), cfg).GetCombined()
+
+	assert.Regexp(t, `Operation: &runtime\.OperationInfo\{\s+ID:\s+"GetUser",\s+Method:\s+"GET",\s+Path:\s+"/users/\{id\}",\s+Tags:\s+\[\]string\{"users", "admin \\"beta\\""\},\s+\},`, code)
+	assert.Regexp(t, `Operation: &runtime\.OperationInfo\{\s+ID:\s+"Health",\s+Method:\s+"GET",\s+Path:\s+"/health",\s+\},`, code)
+}
@@ -11,8 +11,10 @@
 package codegen
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
+	"time"
 )
 
 // OperationDefinition describes an Operation.
@@ -21,17 +23,28 @@ import (
 // Description string from OpenAPI spec.
 // Method The HTTP method for this operation.
 // Path The path for this operation.
+// Tags The tags of the operation from OpenAPI spec.
 // PathParams Parameters in the path
 // Header HTTP headers.
 // Query Query
 // TypeDefinitions These are all the types we need to define for this operation.
 // BodyRequired Whether the body is required for this operation.
+// Deprecated Whether the operation is deprecated, with DeprecationReason from x-deprecated-reason.
+// Sunset The HTTP-date from x-sunset, sent in the Sunset response header.
+// DeprecatedAt The structured-field date from x-deprecated-at, e.g. @1767225600, sent in the Deprecation response header.
+// Retryable Whether the operation is safe to retry, from x-retryable. Nil falls back to the method.
+// Idempotent Whether requests carry an Idempotency-Key header, from x-idempotent.
+// Timeout The deadline of each call to the operation, from x-timeout. Zero for none.
+// Pagination How to iterate over the pages of the operation, from x-pagination.
+// LongRunning How to poll for the completion of the operation, from x-long-running.
+// ClientGroup The sub-client of the operation with client.group-by, empty for the root client.
 type OperationDefinition struct {
 	ID          string
 	Summary     string
 	Description string
 	Method      string
 	Path        string
+	Tags        []string
 	PathParams  *TypeDefinition
 	Header      *RequestParametersDefinition
 	Query       *RequestParametersDefinition
@@ -45,6 +58,23 @@ type OperationDefinition struct {
 
 	// MCP contains x-mcp extension configuration for MCP tool generation
 	MCP *MCPExtension
+
+	Deprecated        bool
+	DeprecationReason string
+	Sunset            string
+	DeprecatedAt      string
+
+	Retryable  *bool
+	Idempotent bool
+	Timeout    time.Duration
+
+	Pagination    *PaginationDefinition
+	paginationExt *PaginationExtension
+
+	LongRunning    *LongRunningDefinition
+	longRunningExt *LongRunningExtension
+
+	ClientGroup string
 }
 
 // RequiresParamObject indicates If we have parameters other than path parameters, they're bundled into an
@@ -78,6 +108,58 @@ func (o OperationDefinition) HasRequestOptions() bool {
 	return o.PathParams != nil || o.Header != nil || o.Query != nil || o.Body != nil
 }
 
+// CapturesResponse returns true if the generated client method records its response with
+// runtime.CaptureResponse, for the <Op>All method of link pagination to read the Link header
+// and the <Op>AndWait method of long-running operations to read the Location header.
+func (o OperationDefinition) CapturesResponse() bool {
+	return (o.Pagination != nil && o.Pagination.Type == PaginationLink) || o.LongRunning != nil
+}
+
+// DeprecationComment returns the "Deprecated:" doc comment of a deprecated operation.
+func (o OperationDefinition) DeprecationComment() string {
+	if !o.Deprecated {
+		return ""
+	}
+	return deprecationComment(o.DeprecationReason)
+}
+
+// DeprecatedParam is a deprecated query or header parameter.
+// IsSetExpr checks if the parameter is set in the request options.
+type DeprecatedParam struct {
+	Name      string
+	In        string
+	IsSetExpr string
+}
+
+// DeprecatedParams returns the deprecated query and header parameters of the operation.
+func (o OperationDefinition) DeprecatedParams() []DeprecatedParam {
+	var res []DeprecatedParam
+	for _, def := range []struct {
+		params *RequestParametersDefinition
+		field  string
+	}{{o.Query, "Query"}, {o.Header, "Header"}} {
+		if def.params == nil {
+			continue
+		}
+		for _, p := range def.params.Params {
+			if p.Spec == nil || !p.Spec.Deprecated {
+				continue
+			}
+			parent := "options." + def.field
+			expr := fmt.Sprintf("options != nil && %s != nil", parent)
+			typeDecl := p.Schema.TypeDecl()
+			switch {
+			case strings.HasPrefix(typeDecl, "[]") || strings.HasPrefix(typeDecl, "map["):
+				expr += fmt.Sprintf(" && len(%s.%s) > 0", parent, p.GoName())
+			case p.IsPointerType():
+				expr += fmt.Sprintf(" && %s.%s != nil", parent, p.GoName())
+			}
+			res = append(res, DeprecatedParam{Name: p.ParamName, In: p.In, IsSetExpr: expr})
+		}
+	}
+	return res
+}
+
 // filterParameterDefinitionByType returns the subset of the specified parameters which are of the
 // specified type.
 func filterParameterDefinitionByType(params []ParameterDefinition, in string) []ParameterDefinition {
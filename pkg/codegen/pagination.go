@@ -0,0 +1,248 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// PaginationType is the pagination style of an operation.
+type PaginationType string
+
+const (
+	PaginationCursor PaginationType = "cursor"
+	PaginationOffset PaginationType = "offset"
+	PaginationPage   PaginationType = "page"
+	PaginationLink   PaginationType = "link"
+)
+
+// PaginationExtension is the x-pagination extension of an operation.
+// Items is the response property holding the items, empty if the response is an array.
+// CursorParam and NextCursor are the query parameter and the response property with the cursor.
+// OffsetParam, LimitParam and PageParam are the query parameters for offset and page pagination.
+type PaginationExtension struct {
+	Type        PaginationType
+	Items       string
+	CursorParam string
+	NextCursor  string
+	OffsetParam string
+	LimitParam  string
+	PageParam   string
+}
+
+// PaginationDefinition describes how the generated client iterates over the pages of an operation.
+// ItemsField is the Go field with the items, empty if the response is an array.
+// Param is the query parameter advanced on each page: the cursor, the offset or the page.
+type PaginationDefinition struct {
+	Type       PaginationType
+	ItemType   string
+	ItemsField string
+	ItemsPtr   bool
+	QueryType  string
+	Param      *PaginationField
+	Limit      *PaginationField
+	NextCursor *PaginationField
+}
+
+// PaginationField is a query parameter or a response field used for pagination.
+// Zero is the Go literal of the zero value, used to detect the last page.
+type PaginationField struct {
+	GoName string
+	Type   string
+	Ptr    bool
+	Zero   string
+}
+
+// ItemsExpr returns the expression for the items of the given page.
+func (p PaginationDefinition) ItemsExpr(page string) string {
+	if p.ItemsField == "" {
+		return "*" + page
+	}
+	if p.ItemsPtr {
+		return "*" + page + "." + p.ItemsField
+	}
+	return page + "." + p.ItemsField
+}
+
+var integerTypeRe = regexp.MustCompile(`^u?int(8|16|32|64)?$`)
+
+// extParsePagination parses the x-pagination extension value, applying the default parameter names.
+func extParsePagination(extPropValue any) (*PaginationExtension, error) {
+	m, ok := extPropValue.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: must be an object, got %T", ErrInvalidPagination, extPropValue)
+	}
+
+	ext := &PaginationExtension{}
+	for key, target := range map[string]*string{
+		"items":        &ext.Items,
+		"cursor-param": &ext.CursorParam,
+		"next-cursor":  &ext.NextCursor,
+		"offset-param": &ext.OffsetParam,
+		"limit-param":  &ext.LimitParam,
+		"page-param":   &ext.PageParam,
+	} {
+		if value, ok := m[key]; ok {
+			str, err := parseString(value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %w", ErrInvalidPagination, key, err)
+			}
+			*target = str
+		}
+	}
+
+	typ, _ := m["type"].(string)
+	ext.Type = PaginationType(typ)
+	switch ext.Type {
+	case PaginationCursor:
+		ext.CursorParam = cmp.Or(ext.CursorParam, "cursor")
+		ext.NextCursor = cmp.Or(ext.NextCursor, "next_cursor")
+	case PaginationOffset:
+		ext.OffsetParam = cmp.Or(ext.OffsetParam, "offset")
+		ext.LimitParam = cmp.Or(ext.LimitParam, "limit")
+	case PaginationPage:
+		ext.PageParam = cmp.Or(ext.PageParam, "page")
+	case PaginationLink:
+	default:
+		return nil, fmt.Errorf("%w: unknown type %q, expected cursor, offset, page or link", ErrInvalidPagination, typ)
+	}
+	return ext, nil
+}
+
+// resolvePagination resolves the x-pagination extension of the operation
+// against its query parameters and success response.
+func resolvePagination(op OperationDefinition, ext *PaginationExtension, typeDefs []TypeDefinition) (*PaginationDefinition, error) {
+	success := op.Response.Success
+	if success == nil || success.IsRaw || op.Response.SuccessStatusCode == 204 {
+		return nil, fmt.Errorf("%w: operation must have a JSON success response", ErrInvalidPagination)
+	}
+
+	res := &PaginationDefinition{Type: ext.Type}
+
+	schema := resolveSchemaByName(success.Schema, typeDefs)
+	itemsSchema := schema
+	if ext.Items != "" {
+		prop, ok := schema.propertyByJSONName(ext.Items)
+		if !ok {
+			return nil, fmt.Errorf("%w: response property %q not found", ErrInvalidPagination, ext.Items)
+		}
+		res.ItemsField = prop.GoName
+		res.ItemsPtr = strings.HasPrefix(prop.GoTypeDef(), "*")
+		itemsSchema = resolveSchemaByName(prop.Schema, typeDefs)
+	}
+	if itemsSchema.ArrayType == nil {
+		return nil, fmt.Errorf("%w: items must be an array, set items to the response property holding them", ErrInvalidPagination)
+	}
+	res.ItemType = itemsSchema.ArrayType.TypeDecl()
+
+	if ext.Type == PaginationLink {
+		return res, nil
+	}
+
+	if op.Query == nil {
+		return nil, fmt.Errorf("%w: operation has no query parameters", ErrInvalidPagination)
+	}
+	res.QueryType = op.Query.TypeDef.Name
+
+	param := map[PaginationType]string{
+		PaginationCursor: ext.CursorParam,
+		PaginationOffset: ext.OffsetParam,
+		PaginationPage:   ext.PageParam,
+	}[ext.Type]
+	field, ok := paginationParam(op.Query, param)
+	if !ok {
+		return nil, fmt.Errorf("%w: query parameter %q not found", ErrInvalidPagination, param)
+	}
+	res.Param = field
+
+	switch ext.Type {
+	case PaginationCursor:
+		prop, ok := schema.propertyByJSONName(ext.NextCursor)
+		if !ok {
+			return nil, fmt.Errorf("%w: response property %q not found", ErrInvalidPagination, ext.NextCursor)
+		}
+		typeDef := prop.GoTypeDef()
+		res.NextCursor = &PaginationField{
+			GoName: prop.GoName,
+			Type:   strings.TrimPrefix(typeDef, "*"),
+			Ptr:    strings.HasPrefix(typeDef, "*"),
+		}
+		res.NextCursor.Zero = zeroLiteral(res.NextCursor.Type)
+		if res.NextCursor.Type != res.Param.Type || res.NextCursor.Zero == "" {
+			return nil, fmt.Errorf("%w: next cursor %q of type %s does not match cursor parameter %q of type %s",
+				ErrInvalidPagination, ext.NextCursor, res.NextCursor.Type, param, res.Param.Type)
+		}
+	case PaginationOffset, PaginationPage:
+		if !integerTypeRe.MatchString(res.Param.Type) {
+			return nil, fmt.Errorf("%w: query parameter %q must be an integer", ErrInvalidPagination, param)
+		}
+		if ext.Type == PaginationOffset {
+			if limit, ok := paginationParam(op.Query, ext.LimitParam); ok && integerTypeRe.MatchString(limit.Type) {
+				res.Limit = limit
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func paginationParam(query *RequestParametersDefinition, name string) (*PaginationField, bool) {
+	idx := slices.IndexFunc(query.Params, func(p ParameterDefinition) bool {
+		return p.ParamName == name
+	})
+	if idx < 0 {
+		return nil, false
+	}
+	p := query.Params[idx]
+	typ := strings.TrimPrefix(p.TypeDef(), "*")
+	return &PaginationField{
+		GoName: p.GoName(),
+		Type:   typ,
+		Ptr:    p.IsPointerType(),
+		Zero:   zeroLiteral(typ),
+	}, true
+}
+
+// zeroLiteral returns the zero value literal of string and numeric types, or an empty string.
+func zeroLiteral(goType string) string {
+	switch {
+	case goType == "string":
+		return `""`
+	case integerTypeRe.MatchString(goType), goType == "float32", goType == "float64":
+		return "0"
+	}
+	return ""
+}
+
+// resolveSchemaByName follows named types to the schema with their properties or array items.
+func resolveSchemaByName(schema GoSchema, typeDefs []TypeDefinition) GoSchema {
+	for range 10 {
+		if len(schema.Properties) > 0 || schema.ArrayType != nil {
+			return schema
+		}
+		name := schema.RefType
+		if name == "" {
+			name = schema.GoType
+		}
+		idx := slices.IndexFunc(typeDefs, func(td TypeDefinition) bool {
+			return td.Name == name
+		})
+		if idx < 0 {
+			return schema
+		}
+		schema = typeDefs[idx].Schema
+	}
+	return schema
+}
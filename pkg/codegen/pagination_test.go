@@ -0,0 +1,122 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPagination(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	code := generateCode(t, readTestdata(t, "pagination.yml"), cfg).GetCombined()
+
+	t.Run("cursor", func(t *testing.T) {
+		assert.Contains(t, code, "ListUsersAll(ctx context.Context, options *ListUsersRequestOptions, reqEditors ...runtime.RequestEditorFn) iter.Seq2[User, error]")
+		assert.Contains(t, code, "items := page.Data")
+		assert.Regexp(t, `next := \*page\.NextCursor\s+if next == "" \{\s+return\s+\}\s+if seen\[next\] \{\s+yield\(zero, fmt\.Errorf\("%w: cursor %v", runtime\.ErrPaginationLoop, next\)\)\s+return\s+\}\s+seen\[next\] = true\s+query\.Cursor = &next`, code)
+		assert.Regexp(t, `seen := make\(map\[string\]bool\)\s+if query\.Cursor != nil \{\s+seen\[\*query\.Cursor\] = true\s+\}`, code)
+	})
+
+	t.Run("offset", func(t *testing.T) {
+		assert.Contains(t, code, "iter.Seq2[Order, error]")
+		assert.Contains(t, code, "if query.Limit != nil && len(items) < int(*query.Limit) {")
+		assert.Regexp(t, `current := query\.Offset\s+next := current \+ len\(items\)\s+query\.Offset = next`, code)
+	})
+
+	t.Run("page", func(t *testing.T) {
+		assert.Contains(t, code, "iter.Seq2[string, error]")
+		assert.Contains(t, code, "items := *page\n")
+		assert.Regexp(t, `current := 1\s+if query\.Page != nil \{\s+current = \*query\.Page\s+\}\s+next := current \+ 1`, code)
+	})
+
+	t.Run("link", func(t *testing.T) {
+		assert.Contains(t, code, "ListLogsAll(ctx context.Context, reqEditors ...runtime.RequestEditorFn) iter.Seq2[ListLogs_Response_Entries_Item, error]")
+		assert.Regexp(t, `if page\.Entries != nil \{\s+items = \*page\.Entries\s+\}`, code)
+		assert.Contains(t, code, "page, err := c.ListLogs(pageCtx, editors...)")
+		assert.Contains(t, code, "next := runtime.NextLink(captured.Response.Headers, current)")
+		assert.Contains(t, code, "yield(zero, fmt.Errorf(\"%w: %s\", runtime.ErrPaginationLoop, next))")
+		assert.Equal(t, 1, strings.Count(code, "runtime.CaptureResponse(ctx, req, resp)"))
+	})
+
+	assert.Equal(t, 8, strings.Count(code, "All(ctx context.Context"))
+}
+
+func TestPagination_invalid(t *testing.T) {
+	spec := func(pagination, params string) string {
+		return `
+openapi: 3.0.3
+info:
+  title: Pagination
+  version: 1.0.0
+paths:
+  /users:
+    get:
+      operationId: listUsers
+      x-pagination: ` + pagination + `
+      parameters: ` + params + `
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  data:
+                    type: array
+                    items:
+                      type: string
+                  next_cursor:
+                    type: string
+`
+	}
+	cursorParam := `[{name: cursor, in: query, schema: {type: string}}]`
+
+	tests := []struct {
+		name       string
+		pagination string
+		params     string
+		err        string
+	}{
+		{"not an object", `cursor`, cursorParam, "must be an object"},
+		{"unknown type", `{type: token}`, cursorParam, `unknown type "token"`},
+		{"missing items", `{type: cursor, items: users}`, cursorParam, `response property "users" not found`},
+		{"items not an array", `{type: cursor}`, cursorParam, "items must be an array"},
+		{"missing param", `{type: cursor, items: data}`, `[]`, "operation has no query parameters"},
+		{"param not found", `{type: cursor, items: data, cursor-param: after}`, cursorParam, `query parameter "after" not found`},
+		{"cursor type mismatch", `{type: cursor, items: data}`, `[{name: cursor, in: query, schema: {type: integer}}]`, "does not match cursor parameter"},
+		{"page not an integer", `{type: page, items: data}`, `[{name: page, in: query, schema: {type: string}}]`, `query parameter "page" must be an integer`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Generate([]byte(spec(tt.pagination, tt.params)), Configuration{
+				PackageName: "api",
+				Generate:    &GenerateOptions{Client: true},
+			})
+			require.ErrorIs(t, err, ErrInvalidPagination)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"go/format"
 	"os"
+	"path"
 	"slices"
 	"sort"
 	"strings"
@@ -66,6 +67,7 @@ type ParseOptions struct {
 	OmitDescription        bool
 	DefaultIntType         string
 	AlwaysPrefixEnumValues bool
+	OpenEnums              bool
 	SkipValidation         bool
 
 	// ErrorMapping maps response type names to the field that should be used
@@ -77,12 +79,26 @@ type ParseOptions struct {
 	// Key is the Go struct tag name, value is the OpenAPI schema field to extract.
 	AutoExtraTags map[string]string
 
+	// TypeMappings overrides the Go type used for OpenAPI type/format pairs.
+	TypeMappings TypeMappings
+
+	// Codecs lists the media type patterns with typed bodies, marshaled by runtime codecs.
+	Codecs []string
+
 	// runtime options
 	typeTracker  *TypeTracker
 	reference    string
 	path         []string
 	specLocation SpecLocation
 
+	// xmlTags adds xml struct tags to generated fields, see operationXMLTags.
+	// xmlRefs are the component schemas with xml struct tags.
+	xmlTags bool
+	xmlRefs map[string]bool
+
+	// strictResponses keeps the default response and each status range as a response of strict handlers.
+	strictResponses bool
+
 	// Track visited schema paths to prevent infinite recursion
 	visited map[string]bool
 
@@ -106,6 +122,29 @@ func (o ParseOptions) WithSpecLocation(specLocation SpecLocation) ParseOptions {
 	return o
 }
 
+// WithXMLTags enables the xml struct tags and XMLName fields generated from the OpenAPI xml object,
+// for the schemas of the operations selected by operationXMLTags.
+func (o ParseOptions) WithXMLTags(enabled bool) ParseOptions {
+	o.xmlTags = enabled
+	return o
+}
+
+// hasCodec returns true if bodies of the content type are typed and marshaled by a runtime codec.
+// Content types which are not raw, such as JSON, never use codecs.
+func (o ParseOptions) hasCodec(contentType string) bool {
+	if !isRawContentType(contentType) {
+		return false
+	}
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	for _, pattern := range o.Codecs {
+		if ok, _ := path.Match(strings.ToLower(pattern), mediaType); ok {
+			return true
+		}
+	}
+	return false
+}
+
 type EnumContext struct {
 	Enums       []EnumDefinition
 	Imports     []string
@@ -130,12 +169,35 @@ type TplTypeContext struct {
 
 // TplOperationsContext is the context passed to templates to generate client code.
 type TplOperationsContext struct {
-	Operations    []OperationDefinition
-	Imports       []string
-	Config        Configuration
-	WithHeader    bool
-	ServerOptions *ServerOptions
-	PackageName   string
+	Operations      []OperationDefinition
+	Imports         []string
+	Config          Configuration
+	WithHeader      bool
+	ServerOptions   *ServerOptions
+	PackageName     string
+	ClientGroups    []OperationGroupDefinition
+	ServiceGroups   []OperationGroupDefinition
+	ServiceGroup    *OperationGroupDefinition
+	SensitiveFields []SensitiveField
+}
+
+// HasIdempotentOperations returns true if any operation is marked with x-idempotent.
+func (c TplOperationsContext) HasIdempotentOperations() bool {
+	return slices.ContainsFunc(c.Operations, func(op OperationDefinition) bool { return op.Idempotent })
+}
+
+// ClientOperations returns the operations of the root client, without those of the sub-clients.
+func (c TplOperationsContext) ClientOperations() []OperationDefinition {
+	if len(c.ClientGroups) == 0 {
+		return c.Operations
+	}
+	var res []OperationDefinition
+	for _, op := range c.Operations {
+		if op.ClientGroup == "" {
+			res = append(res, op)
+		}
+	}
+	return res
 }
 
 // NewParser creates a new Parser with the provided ParseConfig and ParseContext.
@@ -207,12 +269,24 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 
 	if len(p.ctx.Operations) > 0 && p.cfg.Generate.Client {
 		opsCtx := &TplOperationsContext{
-			Operations: p.ctx.Operations,
-			Imports:    p.ctx.Imports,
-			Config:     p.cfg,
-			WithHeader: withHeader,
+			Operations:      p.ctx.Operations,
+			Imports:         p.ctx.Imports,
+			Config:          p.cfg,
+			WithHeader:      withHeader,
+			ClientGroups:    p.ctx.ClientGroups,
+			SensitiveFields: p.ctx.SensitiveFields,
+		}
+		clientTemplates := []string{"client", "client-options"}
+		if p.cfg.Client.Fake {
+			clientTemplates = append(clientTemplates, "client-fake")
+		}
+		if p.cfg.Client.RequestBuilders {
+			clientTemplates = append(clientTemplates, "client-requests")
+		}
+		if p.cfg.Generate.Handler != nil && p.cfg.Generate.Handler.Kind.IsNetHTTP() {
+			clientTemplates = append(clientTemplates, "client-in-process")
 		}
-		for _, tmpl := range []string{"client", "client-options"} {
+		for _, tmpl := range clientTemplates {
 			out, err := p.ParseTemplates([]string{tmpl + ".tmpl"}, opsCtx)
 			if err != nil {
 				return nil, fmt.Errorf("error generating code for client: %w", err)
@@ -231,10 +305,11 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 	// Generate handler code if handler generation is enabled
 	if len(p.ctx.Operations) > 0 && p.cfg.Generate.Handler != nil {
 		opsCtx := &TplOperationsContext{
-			Operations: p.ctx.Operations,
-			Imports:    p.ctx.Imports,
-			Config:     p.cfg,
-			WithHeader: withHeader,
+			Operations:    p.ctx.Operations,
+			Imports:       p.ctx.Imports,
+			Config:        p.cfg,
+			WithHeader:    withHeader,
+			ServiceGroups: p.ctx.ServiceGroups,
 		}
 		// Determine which templates to use based on handler kind
 		handlerKind := p.cfg.Generate.Handler.Kind
@@ -320,30 +395,42 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 		}
 
 		// Generate service implementation stub - scaffolded file
+		// With generate.handler.group-by, each group also gets its own service file
 		serviceCtx := &TplOperationsContext{
-			Operations:  p.ctx.Operations,
-			Imports:     p.ctx.Imports,
-			Config:      p.cfg,
-			WithHeader:  withHeader,
-			PackageName: scaffoldPackage,
-		}
-		out, err := p.ParseTemplates([]string{sharedPrefix + "service.tmpl"}, serviceCtx)
-		if err != nil {
-			return GeneratedCode{}, fmt.Errorf("error generating code for handler implementation: %w", err)
-		}
+			Operations:    p.ctx.Operations,
+			Imports:       p.ctx.Imports,
+			Config:        p.cfg,
+			WithHeader:    withHeader,
+			PackageName:   scaffoldPackage,
+			ServiceGroups: p.ctx.ServiceGroups,
+		}
+		serviceFiles := map[string]*TplOperationsContext{"service": serviceCtx}
+		for i, group := range p.ctx.ServiceGroups {
+			groupCtx := *serviceCtx
+			groupCtx.Operations = group.Operations
+			groupCtx.ServiceGroup = &p.ctx.ServiceGroups[i]
+			serviceFiles[strcase.ToSnake(group.TypeName(p.cfg.Generate.Handler.Name))] = &groupCtx
+		}
+
+		for name, ctx := range serviceFiles {
+			out, err := p.ParseTemplates([]string{sharedPrefix + "service.tmpl"}, ctx)
+			if err != nil {
+				return GeneratedCode{}, fmt.Errorf("error generating code for handler implementation: %w", err)
+			}
 
-		// Scaffold files are always separate files, so always format them
-		formatted, err := FormatCode(out)
-		if err != nil {
-			return nil, fmt.Errorf("error formatting service: %w", err)
-		}
+			// Scaffold files are always separate files, so always format them
+			formatted, err := FormatCode(out)
+			if err != nil {
+				return nil, fmt.Errorf("error formatting %s: %w", name, err)
+			}
 
-		// Use directory path as key if scaffold has different output directory
-		serviceKey := "service"
-		if scaffoldOutput.Directory != "" && scaffoldOutput.Directory != p.cfg.Output.Directory {
-			serviceKey = scaffoldOutput.Directory + "/service"
+			// Use directory path as key if scaffold has different output directory
+			serviceKey := name
+			if scaffoldOutput.Directory != "" && scaffoldOutput.Directory != p.cfg.Output.Directory {
+				serviceKey = scaffoldOutput.Directory + "/" + name
+			}
+			scaffoldOut[serviceKey] = formatted
 		}
-		scaffoldOut[serviceKey] = formatted
 
 		// Generate server main.go if server generation is enabled - scaffolded file
 		if p.cfg.Generate.Handler.Server != nil {
@@ -386,10 +473,11 @@ func (p *Parser) Parse() (GeneratedCode, error) {
 			return nil, fmt.Errorf("MCP server generation requires client generation to be enabled (set generate.client: true)")
 		}
 		opsCtx := &TplOperationsContext{
-			Operations: p.ctx.Operations,
-			Imports:    p.ctx.Imports,
-			Config:     p.cfg,
-			WithHeader: withHeader,
+			Operations:   p.ctx.Operations,
+			Imports:      p.ctx.Imports,
+			Config:       p.cfg,
+			WithHeader:   withHeader,
+			ClientGroups: p.ctx.ClientGroups,
 		}
 		out, err := p.ParseTemplates([]string{"mcp/tools.tmpl"}, opsCtx)
 		if err != nil {
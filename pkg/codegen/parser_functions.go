@@ -15,6 +15,7 @@ import (
 	"strconv"
 	"strings"
 	"text/template"
+	"time"
 	"unicode"
 	"unicode/utf8"
 
@@ -47,6 +48,7 @@ var TemplateFunctions = template.FuncMap{
 	"filterOmitEmpty": filterOmitEmpty,
 	"deref":           derefBool,
 	"replace":         strings.ReplaceAll,
+	"durationExpr":    durationExpr,
 }
 
 // uppercaseFirstCharacter Uppercases the first character in a string.
@@ -72,6 +74,24 @@ func lowercaseFirstCharacter(str string) string {
 	return string(runes)
 }
 
+// durationExpr returns the Go expression of a duration, in its largest whole unit, e.g. 30 * time.Second.
+func durationExpr(d time.Duration) string {
+	for _, unit := range []struct {
+		d    time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+		{time.Millisecond, "time.Millisecond"},
+	} {
+		if d%unit.d == 0 {
+			return fmt.Sprintf("%d * %s", d/unit.d, unit.name)
+		}
+	}
+	return fmt.Sprintf("time.Duration(%d)", d)
+}
+
 // Ternary function
 func ternary(cond bool, trueVal, falseVal string) string {
 	if cond {
@@ -12,6 +12,7 @@ package codegen
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -76,3 +77,11 @@ func TestEscapeGoString(t *testing.T) {
 		})
 	}
 }
+
+func TestDurationExpr(t *testing.T) {
+	assert.Equal(t, "2 * time.Hour", durationExpr(2*time.Hour))
+	assert.Equal(t, "90 * time.Minute", durationExpr(90*time.Minute))
+	assert.Equal(t, "3 * time.Second", durationExpr(3*time.Second))
+	assert.Equal(t, "1500 * time.Millisecond", durationExpr(1500*time.Millisecond))
+	assert.Equal(t, "time.Duration(100)", durationExpr(100))
+}
@@ -181,40 +181,45 @@ func findOperationRefs(model *v3high.Document) map[string]bool {
 			}
 		}
 
-		// Walk component schemas that are already in refSet to collect refs to other schemas (for composition)
-		// This is done iteratively to handle transitive references
-		if model.Components.Schemas != nil {
-			// Keep expanding until no new refs are added
-			for {
-				prevSize := len(refSet)
-				for schemaName, schemaProxy := range model.Components.Schemas.FromOldest() {
-					if schemaProxy == nil {
-						continue
-					}
-					schemaRef := fmt.Sprintf("#/components/schemas/%s", schemaName)
-					// Only process schemas that are already in the refSet
-					if !refSet[schemaRef] {
-						continue
-					}
-					// Check if the schema proxy itself is a $ref to another schema
-					if targetRef := schemaProxy.GoLow().GetReference(); targetRef != "" {
-						refSet[targetRef] = true
-					}
-					// Collect refs from the schema's content (allOf, oneOf, anyOf, properties, etc.)
-					collectSchemaRefs(schemaProxy.Schema(), refSet, model)
-				}
-				// If no new refs were added, we're done
-				if len(refSet) == prevSize {
-					break
-				}
-			}
-		}
+		expandSchemaRefs(model, refSet)
 	}
 
 	slog.Debug("All collected refs", "count", len(refSet))
 	return refSet
 }
 
+// expandSchemaRefs walks the component schemas in refSet to collect the refs to other schemas (for composition).
+// This is done iteratively to handle transitive references.
+func expandSchemaRefs(model *v3high.Document, refSet map[string]bool) {
+	if model.Components == nil || model.Components.Schemas == nil {
+		return
+	}
+	// Keep expanding until no new refs are added
+	for {
+		prevSize := len(refSet)
+		for schemaName, schemaProxy := range model.Components.Schemas.FromOldest() {
+			if schemaProxy == nil {
+				continue
+			}
+			schemaRef := fmt.Sprintf("#/components/schemas/%s", schemaName)
+			// Only process schemas that are already in the refSet
+			if !refSet[schemaRef] {
+				continue
+			}
+			// Check if the schema proxy itself is a $ref to another schema
+			if targetRef := schemaProxy.GoLow().GetReference(); targetRef != "" {
+				refSet[targetRef] = true
+			}
+			// Collect refs from the schema's content (allOf, oneOf, anyOf, properties, etc.)
+			collectSchemaRefs(schemaProxy.Schema(), refSet, model)
+		}
+		// If no new refs were added, we're done
+		if len(refSet) == prevSize {
+			return
+		}
+	}
+}
+
 // addParentSchemaRef adds the parent schema reference if the given ref is a property reference
 // e.g., if ref is "#/components/schemas/Foo/properties/bar", also add "#/components/schemas/Foo"
 func addParentSchemaRef(ref string, refSet map[string]bool) {
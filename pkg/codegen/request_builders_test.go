@@ -0,0 +1,47 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientRequestBuilders(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+		Client: &Client{
+			RequestBuilders: true,
+		},
+	}
+
+	code := generateCode(t, readTestdata(t, "pagination.yml"), cfg).GetCombined()
+
+	assert.Contains(t, code, "func NewListUsersRequest(ctx context.Context, baseURL string, options *ListUsersRequestOptions, reqEditors ...runtime.RequestEditorFn) (*http.Request, error) {")
+	assert.Contains(t, code, "func NewListLogsRequest(ctx context.Context, baseURL string, reqEditors ...runtime.RequestEditorFn) (*http.Request, error) {")
+	assert.Contains(t, code, "func ParseListUsersResponse(resp *http.Response) (*ListUsersResponse, error) {")
+	// The parsers have no client to take options from.
+	assert.Contains(t, code, `runtime.ValidateResponse(nil, "ListUsers", resp.StatusCode, target)`)
+	assert.Contains(t, code, "runtime.WithResponse(nil, resp))")
+
+	cfg.Client.RequestBuilders = false
+	codes, err := Generate([]byte(readTestdata(t, "pagination.yml")), cfg)
+	require.NoError(t, err)
+	assert.NotContains(t, codes.GetCombined(), "NewListUsersRequest")
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryableOperations(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	code := generateCode(t, readTestdata(t, "retryable.yml"), cfg).GetCombined()
+
+	assert.Regexp(t, `Method:\s+"POST",\s+Retryable:\s+runtime\.Ptr\(true\),`, code)
+	assert.Regexp(t, `RequestURL:\s+c\.apiClient\.GetBaseURL\(\) \+ "/payments/search",\s+Method:\s+"GET",\s+Retryable:\s+runtime\.Ptr\(false\),`, code)
+	assert.Equal(t, 2, strings.Count(code, "Retryable:"))
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/uptrace/oapi-codegen-dd/v3/pkg/runtime"
 )
 
 // GoSchema describes an OpenAPI schema, with lots of helper fields to use in the templating engine.
@@ -24,9 +25,15 @@ import (
 // RefType is the type name of the schema, if it has one.
 // ArrayType is the schema of the array element, if it's an array.
 // EnumValues is a map of enum values.
+// EnumDescriptions maps enum values to their descriptions from x-enum-descriptions.
+// OpenEnum is true if the enum accepts values which are not listed.
 // Properties is a list of fields for an object.
 // HasAdditionalProperties is true if the object has additional properties.
 // AdditionalPropertiesType is the type of additional properties.
+// PatternProperties is a list of patternProperties, in spec order.
+// PropertyNames holds the propertyNames constraints for the object keys.
+// Conditionals holds the if/then/else, dependentRequired and dependentSchemas rules.
+// Tuple describes the positional elements of an array with prefixItems.
 // AdditionalTypes is a list of auxiliary types that may be needed.
 // SkipOptionalPointer is true if the type doesn't need a * in front when it's optional.
 // Description is the description of the element.
@@ -39,9 +46,15 @@ type GoSchema struct {
 	RefType                  string
 	ArrayType                *GoSchema
 	EnumValues               map[string]string
+	EnumDescriptions         map[string]string
+	OpenEnum                 bool
 	Properties               []Property
 	HasAdditionalProperties  bool
 	AdditionalPropertiesType *GoSchema
+	PatternProperties        []PatternProperty
+	PropertyNames            *PropertyNames
+	Conditionals             []SchemaConditional
+	Tuple                    *TupleSchema
 	AdditionalTypes          []TypeDefinition
 	SkipOptionalPointer      bool
 	Description              string
@@ -136,6 +149,21 @@ func (s GoSchema) NeedsValidation() bool {
 		return false
 	}
 
+	// Keys or values of patternProperties/propertyNames need to be checked
+	if s.needsDynamicPropertiesValidation() {
+		return true
+	}
+
+	// Tuple elements are validated by position
+	if s.Tuple != nil {
+		return s.Tuple.needsValidation() || (s.Tuple.Rest != nil && s.Constraints.MaxItems != nil)
+	}
+
+	// if/then/else, dependentRequired and dependentSchemas are checked in Validate()
+	if s.needsConditionalValidation() {
+		return true
+	}
+
 	// If it has validation tags, it needs validation
 	if len(s.Constraints.ValidationTags) > 0 {
 		return true
@@ -252,6 +280,10 @@ func (s GoSchema) createGoStruct(fields []string) string {
 	// Append all the field definitions
 	objectParts = append(objectParts, fields...)
 
+	for _, p := range s.PatternProperties {
+		objectParts = append(objectParts, fmt.Sprintf("%s map[string]%s `json:\"-\"`", p.GoName, p.TypeDecl()))
+	}
+
 	// Close the struct
 	if s.HasAdditionalProperties {
 		objectParts = append(
@@ -789,7 +821,7 @@ func schemaHasAdditionalProperties(schema *base.Schema) bool {
 }
 
 func replaceInlineTypes(src GoSchema, options ParseOptions) (GoSchema, string) {
-	if (len(src.Properties) == 0 && len(src.UnionElements) == 0) || src.RefType != "" {
+	if (len(src.Properties) == 0 && len(src.UnionElements) == 0 && !src.needsDynamicPropertiesValidation() && src.Tuple == nil) || src.RefType != "" {
 		return src, ""
 	}
 
@@ -832,12 +864,41 @@ func replaceInlineTypes(src GoSchema, options ParseOptions) (GoSchema, string) {
 	}, name
 }
 
+// nameInlineValueType registers a named type for inline objects, unions and tuples
+// used as map values or tuple elements, which can't be declared inline.
+func nameInlineValueType(s GoSchema, path []string, ref string, options ParseOptions) GoSchema {
+	if s.RefType != "" || (len(s.Properties) == 0 && !s.HasAdditionalProperties && len(s.UnionElements) == 0 && s.Tuple == nil) {
+		return s
+	}
+
+	typeName := pathToTypeName(path)
+	if options.typeTracker.Exists(typeName) {
+		typeName = options.typeTracker.generateUniqueName(typeName)
+	}
+	typeDef := TypeDefinition{
+		Name:           typeName,
+		JsonName:       strings.Join(path, "."),
+		Schema:         s,
+		SpecLocation:   SpecLocationUnion,
+		NeedsMarshaler: needsMarshaler(s),
+	}
+	options.typeTracker.register(typeDef, ref)
+	s.RefType = typeName
+	s.AdditionalTypes = append(s.AdditionalTypes, typeDef)
+	return s
+}
+
 func enhanceSchema(src, other GoSchema, options ParseOptions) GoSchema {
 	if len(other.UnionElements) == 0 && len(other.Properties) == 0 {
 		return src
 	}
 
 	src.Properties = append(src.Properties, other.Properties...)
+	src.PatternProperties = append(src.PatternProperties, other.PatternProperties...)
+	if src.PropertyNames == nil {
+		src.PropertyNames = other.PropertyNames
+	}
+	src.Conditionals = append(src.Conditionals, other.Conditionals...)
 	src.Discriminator = other.Discriminator
 	src.UnionElements = other.UnionElements
 	src.AdditionalTypes = append(src.AdditionalTypes, other.AdditionalTypes...)
@@ -885,6 +946,32 @@ func hasSensitiveData(schema GoSchema) bool {
 	return false
 }
 
+// SensitiveField is a JSON property marked with x-sensitive-data.
+type SensitiveField struct {
+	Name   string
+	Config *runtime.SensitiveDataConfig
+}
+
+// collectSensitiveFields collects the JSON properties marked with x-sensitive-data, sorted by name.
+// The first configuration wins when types mark the same property name differently.
+func collectSensitiveFields(typeDefs []TypeDefinition) []SensitiveField {
+	seen := map[string]bool{}
+	var res []SensitiveField
+	for _, td := range extractAllTypeDefinitions(typeDefs) {
+		for _, p := range td.Schema.Properties {
+			if p.SensitiveData == nil || seen[p.JsonFieldName] {
+				continue
+			}
+			seen[p.JsonFieldName] = true
+			res = append(res, SensitiveField{Name: p.JsonFieldName, Config: p.SensitiveData})
+		}
+	}
+	slices.SortFunc(res, func(a, b SensitiveField) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return res
+}
+
 // isStandardComponentReference checks if a $ref is a standard component reference
 // (e.g., #/components/schemas/Foo) vs a deep path reference
 // (e.g., #/paths/.../properties/time)
@@ -0,0 +1,700 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"go.yaml.in/yaml/v4"
+)
+
+// SchemaConditional is an if/then/else, dependentRequired or dependentSchemas rule of an object.
+// The rules are evaluated against the struct fields in the generated Validate() method.
+type SchemaConditional struct {
+	// If is the condition subschema. It's nil for dependentRequired and dependentSchemas.
+	If *base.Schema
+
+	// DependsOn is the JSON name of the property that triggers Then when present.
+	DependsOn string
+
+	Then *base.Schema
+	Else *base.Schema
+
+	// keyword is the keyword of the rule, used in warnings: if, dependentRequired or dependentSchemas.
+	keyword string
+}
+
+// newSchemaConditionals collects the conditional rules of the schema in spec order:
+// if/then/else first, then dependentRequired and dependentSchemas.
+func newSchemaConditionals(schema *base.Schema) []SchemaConditional {
+	if schema == nil {
+		return nil
+	}
+
+	var res []SchemaConditional
+	if schema.If != nil && (schema.Then != nil || schema.Else != nil) {
+		res = append(res, SchemaConditional{
+			If:      proxySchema(schema.If),
+			Then:    proxySchema(schema.Then),
+			Else:    proxySchema(schema.Else),
+			keyword: "if",
+		})
+	}
+
+	for name, required := range schema.DependentRequired.FromOldest() {
+		if len(required) == 0 {
+			continue
+		}
+		res = append(res, SchemaConditional{
+			DependsOn: name,
+			Then:      &base.Schema{Required: required},
+			keyword:   "dependentRequired",
+		})
+	}
+
+	for name, proxy := range schema.DependentSchemas.FromOldest() {
+		if s := proxySchema(proxy); s != nil {
+			res = append(res, SchemaConditional{
+				DependsOn: name,
+				Then:      s,
+				keyword:   "dependentSchemas",
+			})
+		}
+	}
+
+	return res
+}
+
+func proxySchema(proxy *base.SchemaProxy) *base.Schema {
+	if proxy == nil {
+		return nil
+	}
+	return proxy.Schema()
+}
+
+// needsConditionalValidation returns true if the struct has conditional rules.
+func (s GoSchema) needsConditionalValidation() bool {
+	return len(s.Conditionals) > 0 && s.isStructType()
+}
+
+// generateConditionalValidation generates the checks for the conditional rules of a struct.
+// Rules referring to unknown properties or using unsupported keywords in the if subschema are skipped,
+// they're reported by warnUnsupportedConditionals when the schema is parsed.
+func (s GoSchema) generateConditionalValidation(alias, validatorVar string) []string {
+	var lines []string
+	for _, c := range s.Conditionals {
+		lines = append(lines, s.conditionalLines(c, alias, validatorVar)...)
+	}
+	return lines
+}
+
+func (s GoSchema) conditionalLines(c SchemaConditional, alias, validatorVar string) []string {
+	var cond string
+	if c.DependsOn != "" {
+		prop, ok := s.propertyByJSONName(c.DependsOn)
+		if !ok {
+			return nil
+		}
+		cond = presenceExpr(alias, prop)
+		if cond == "" {
+			// The property is always present.
+			return s.subschemaLines(c.Then, c.DependsOn, alias, validatorVar)
+		}
+	} else {
+		expr, ok := s.matchExpr(c.If, alias, validatorVar)
+		if !ok {
+			return nil
+		}
+		if expr == "true" {
+			return s.subschemaLines(c.Then, "", alias, validatorVar)
+		}
+		cond = expr
+	}
+
+	then, els, shared := splitSharedAllOf(c.Then, c.Else)
+	thenLines := s.subschemaLines(then, c.DependsOn, alias, validatorVar)
+	elseLines := s.subschemaLines(els, "", alias, validatorVar)
+
+	var lines []string
+	switch {
+	case len(thenLines) > 0:
+		lines = append(lines, fmt.Sprintf("if %s {", cond))
+		lines = append(lines, indentLines(thenLines)...)
+		if len(elseLines) > 0 {
+			lines = append(lines, "} else {")
+			lines = append(lines, indentLines(elseLines)...)
+		}
+		lines = append(lines, "}")
+	case len(elseLines) > 0:
+		lines = append(lines, fmt.Sprintf("if !(%s) {", cond))
+		lines = append(lines, indentLines(elseLines)...)
+		lines = append(lines, "}")
+	}
+	lines = append(lines, s.subschemaLines(shared, "", alias, validatorVar)...)
+	return lines
+}
+
+// splitSharedAllOf extracts the subschema which is the last allOf element of both then and else.
+// mergeConditionals nests conditionals this way, and they're evaluated regardless of the condition.
+func splitSharedAllOf(then, els *base.Schema) (*base.Schema, *base.Schema, *base.Schema) {
+	if then == nil || els == nil || len(then.AllOf) == 0 || len(els.AllOf) == 0 {
+		return then, els, nil
+	}
+	last := then.AllOf[len(then.AllOf)-1]
+	if last != els.AllOf[len(els.AllOf)-1] {
+		return then, els, nil
+	}
+
+	strip := func(s *base.Schema) *base.Schema {
+		res := *s
+		res.AllOf = s.AllOf[:len(s.AllOf)-1]
+		return &res
+	}
+	return strip(then), strip(els), proxySchema(last)
+}
+
+// subschemaLines generates the checks of a then/else/dependentSchemas subschema.
+// dependsOn is set for dependentRequired and dependentSchemas, and is used in the error messages.
+func (s GoSchema) subschemaLines(sub *base.Schema, dependsOn, alias, validatorVar string) []string {
+	if sub == nil {
+		return nil
+	}
+
+	var lines []string
+	for _, name := range sub.Required {
+		prop, ok := s.propertyByJSONName(name)
+		if !ok {
+			continue
+		}
+		absent := absenceExpr(alias, prop)
+		if absent == "" {
+			continue
+		}
+		msg := "is required"
+		if dependsOn != "" {
+			msg = fmt.Sprintf("is required when %s is present", dependsOn)
+		}
+		lines = append(lines, fmt.Sprintf("if %s {", absent))
+		lines = append(lines, fmt.Sprintf("    errors = errors.Add(%q, %q)", prop.GoName, msg))
+		lines = append(lines, "}")
+	}
+
+	for name, proxy := range sub.Properties.FromOldest() {
+		prop, ok := s.propertyByJSONName(name)
+		if !ok {
+			continue
+		}
+		checks := s.propertyCheckLines(prop, proxySchema(proxy), alias, validatorVar)
+		if len(checks) == 0 {
+			continue
+		}
+		if present := presenceExpr(alias, prop); present != "" {
+			lines = append(lines, fmt.Sprintf("if %s {", present))
+			lines = append(lines, indentLines(checks)...)
+			lines = append(lines, "}")
+		} else {
+			lines = append(lines, checks...)
+		}
+	}
+
+	for _, proxy := range sub.AllOf {
+		lines = append(lines, s.subschemaLines(proxySchema(proxy), dependsOn, alias, validatorVar)...)
+	}
+
+	for _, c := range newSchemaConditionals(sub) {
+		lines = append(lines, s.conditionalLines(c, alias, validatorVar)...)
+	}
+
+	return lines
+}
+
+// propertyCheckLines generates the checks of a property subschema, assuming the property is present.
+func (s GoSchema) propertyCheckLines(prop Property, sub *base.Schema, alias, validatorVar string) []string {
+	if sub == nil {
+		return nil
+	}
+
+	var lines []string
+	value := valueExpr(alias, prop)
+
+	if sub.Const != nil {
+		if lit, ok := literalFor(prop, sub.Const); ok {
+			lines = append(lines, fmt.Sprintf("if !(%s) {", equalExpr(value, lit)))
+			lines = append(lines, fmt.Sprintf("    errors = errors.Add(%q, %q)", prop.GoName, "must be equal to "+sub.Const.Value))
+			lines = append(lines, "}")
+		}
+	}
+
+	if len(sub.Enum) > 0 {
+		if expr, ok := enumExpr(prop, value, sub.Enum); ok {
+			values := make([]string, len(sub.Enum))
+			for i, v := range sub.Enum {
+				values[i] = v.Value
+			}
+			msg := fmt.Sprintf("must be one of [%s]", strings.Join(values, " "))
+			lines = append(lines, fmt.Sprintf("if !%s {", expr))
+			lines = append(lines, fmt.Sprintf("    errors = errors.Add(%q, %q)", prop.GoName, msg))
+			lines = append(lines, "}")
+		}
+	}
+
+	if sub.Pattern != "" && literalKind(prop) == "string" {
+		lines = append(lines, fmt.Sprintf("if !runtime.MatchPattern(%s, string(%s)) {", strconv.Quote(sub.Pattern), value))
+		lines = append(lines, fmt.Sprintf("    errors = errors.Add(%q, %q)", prop.GoName, fmt.Sprintf("must match pattern %q", sub.Pattern)))
+		lines = append(lines, "}")
+	}
+
+	if tags := subschemaTags(prop, sub); tags != "" {
+		lines = append(lines, fmt.Sprintf("if err := %s.Var(%s, %q); err != nil {", validatorVar, value, tags))
+		lines = append(lines, fmt.Sprintf("    errors = errors.Append(%q, err)", prop.GoName))
+		lines = append(lines, "}")
+	}
+
+	return lines
+}
+
+// matchExpr returns a boolean expression reporting whether the struct matches the if subschema.
+// It returns false if the subschema uses keywords which can't be evaluated.
+func (s GoSchema) matchExpr(sub *base.Schema, alias, validatorVar string) (string, bool) {
+	if sub == nil {
+		return "true", true
+	}
+	if ifKeyword(sub) != "" {
+		return "", false
+	}
+
+	var terms []string
+	for _, name := range sub.Required {
+		prop, ok := s.propertyByJSONName(name)
+		if !ok {
+			return "", false
+		}
+		if present := presenceExpr(alias, prop); present != "" {
+			terms = append(terms, present)
+		}
+	}
+
+	for name, proxy := range sub.Properties.FromOldest() {
+		prop, ok := s.propertyByJSONName(name)
+		if !ok {
+			return "", false
+		}
+		expr, ok := propertyMatchExpr(prop, proxySchema(proxy), alias, validatorVar)
+		if !ok {
+			return "", false
+		}
+		if expr == "" {
+			continue
+		}
+		// properties only apply to present values
+		if absent := absenceExpr(alias, prop); absent != "" && !slices.Contains(sub.Required, name) {
+			expr = fmt.Sprintf("(%s || %s)", absent, expr)
+		}
+		terms = append(terms, expr)
+	}
+
+	for _, proxy := range sub.AllOf {
+		expr, ok := s.matchExpr(proxySchema(proxy), alias, validatorVar)
+		if !ok {
+			return "", false
+		}
+		if expr != "true" {
+			terms = append(terms, expr)
+		}
+	}
+
+	if len(terms) == 0 {
+		return "true", true
+	}
+	return strings.Join(terms, " && "), true
+}
+
+// propertyMatchExpr returns a boolean expression reporting whether a present property matches the subschema.
+func propertyMatchExpr(prop Property, sub *base.Schema, alias, validatorVar string) (string, bool) {
+	if sub == nil {
+		return "", true
+	}
+	if propertyKeyword(sub) != "" {
+		return "", false
+	}
+
+	value := valueExpr(alias, prop)
+	var terms []string
+
+	if sub.Const != nil {
+		lit, ok := literalFor(prop, sub.Const)
+		if !ok {
+			return "", false
+		}
+		terms = append(terms, equalExpr(value, lit))
+	}
+
+	if len(sub.Enum) > 0 {
+		expr, ok := enumExpr(prop, value, sub.Enum)
+		if !ok {
+			return "", false
+		}
+		terms = append(terms, expr)
+	}
+
+	if sub.Pattern != "" {
+		if literalKind(prop) != "string" {
+			return "", false
+		}
+		terms = append(terms, fmt.Sprintf("runtime.MatchPattern(%s, string(%s))", strconv.Quote(sub.Pattern), value))
+	}
+
+	if tags := subschemaTags(prop, sub); tags != "" {
+		terms = append(terms, fmt.Sprintf("%s.Var(%s, %q) == nil", validatorVar, value, tags))
+	}
+
+	return strings.Join(terms, " && "), true
+}
+
+// ifKeyword returns the keyword of the if subschema which can't be evaluated, or an empty string.
+func ifKeyword(sub *base.Schema) string {
+	switch {
+	case len(sub.AnyOf) > 0:
+		return "anyOf"
+	case len(sub.OneOf) > 0:
+		return "oneOf"
+	case sub.Not != nil:
+		return "not"
+	case sub.If != nil:
+		return "if"
+	case sub.DependentSchemas != nil:
+		return "dependentSchemas"
+	case sub.DependentRequired != nil:
+		return "dependentRequired"
+	}
+	return ""
+}
+
+// propertyKeyword returns the keyword of a property subschema in the if subschema
+// which can't be evaluated, or an empty string.
+func propertyKeyword(sub *base.Schema) string {
+	switch {
+	case sub.Properties != nil:
+		return "properties"
+	case len(sub.AllOf) > 0:
+		return "allOf"
+	case len(sub.AnyOf) > 0:
+		return "anyOf"
+	case len(sub.OneOf) > 0:
+		return "oneOf"
+	case sub.Not != nil:
+		return "not"
+	}
+	return ""
+}
+
+// unsupportedConditionKeyword returns the path of the first keyword in the if subschema
+// which can't be evaluated, e.g. "properties.kind.not", or an empty string.
+func unsupportedConditionKeyword(sub *base.Schema) string {
+	if sub == nil {
+		return ""
+	}
+	if kw := ifKeyword(sub); kw != "" {
+		return kw
+	}
+	for name, proxy := range sub.Properties.FromOldest() {
+		if p := proxySchema(proxy); p != nil {
+			if kw := propertyKeyword(p); kw != "" {
+				return "properties." + name + "." + kw
+			}
+		}
+	}
+	for _, proxy := range sub.AllOf {
+		if kw := unsupportedConditionKeyword(proxySchema(proxy)); kw != "" {
+			return "allOf." + kw
+		}
+	}
+	return ""
+}
+
+// warnUnsupportedConditionals logs the if/then/else rules of the schema which are not validated,
+// because their if subschema can't be evaluated, and the property names of the rules
+// which are not properties of the schema, whose checks are skipped.
+func warnUnsupportedConditionals(schema *base.Schema, conditionals []SchemaConditional, path []string) {
+	for _, c := range conditionals {
+		if kw := unsupportedConditionKeyword(c.If); kw != "" {
+			slog.Warn("if/then/else is not validated, the if subschema uses an unsupported keyword",
+				"schema", strings.Join(path, "."), "keyword", kw)
+		}
+	}
+
+	known := func(name string) bool {
+		if schema == nil || schema.Properties == nil {
+			return false
+		}
+		_, ok := schema.Properties.Get(name)
+		return ok
+	}
+	for _, c := range conditionals {
+		for _, kw := range c.unknownProperties("", known) {
+			slog.Warn("conditional rule refers to an unknown property, its check is not generated",
+				"schema", strings.Join(path, "."), "keyword", kw)
+		}
+	}
+}
+
+// unknownProperties returns the keyword paths of the property names of the rule which are not known,
+// e.g. "dependentRequired.kind.required.tracking".
+func (c SchemaConditional) unknownProperties(prefix string, known func(string) bool) []string {
+	var res []string
+	if c.DependsOn != "" {
+		prefix += c.keyword + "." + c.DependsOn
+		if !known(c.DependsOn) {
+			res = append(res, prefix)
+		}
+		return append(res, unknownSubschemaProperties(c.Then, prefix, known)...)
+	}
+	res = append(res, unknownSubschemaProperties(c.If, prefix+"if", known)...)
+	res = append(res, unknownSubschemaProperties(c.Then, prefix+"then", known)...)
+	return append(res, unknownSubschemaProperties(c.Else, prefix+"else", known)...)
+}
+
+// unknownSubschemaProperties returns the keyword paths of the required and properties names of the subschema,
+// its allOf elements and nested rules, which are not known.
+func unknownSubschemaProperties(sub *base.Schema, prefix string, known func(string) bool) []string {
+	if sub == nil {
+		return nil
+	}
+
+	var res []string
+	for _, name := range sub.Required {
+		if !known(name) {
+			res = append(res, prefix+".required."+name)
+		}
+	}
+	for name := range sub.Properties.FromOldest() {
+		if !known(name) {
+			res = append(res, prefix+".properties."+name)
+		}
+	}
+	for i, proxy := range sub.AllOf {
+		res = append(res, unknownSubschemaProperties(proxySchema(proxy), prefix+".allOf."+strconv.Itoa(i), known)...)
+	}
+	for _, c := range newSchemaConditionals(sub) {
+		res = append(res, c.unknownProperties(prefix+".", known)...)
+	}
+	return res
+}
+
+func (s GoSchema) propertyByJSONName(name string) (Property, bool) {
+	for _, p := range s.Properties {
+		if p.JsonFieldName == name {
+			return p, true
+		}
+	}
+	return Property{}, false
+}
+
+// presenceExpr returns the expression reporting whether the property is present.
+// It returns an empty string if the property is always present.
+func presenceExpr(alias string, prop Property) string {
+	typeDecl := prop.Schema.TypeDecl()
+	if prop.IsPointerType() || strings.HasPrefix(typeDecl, "[]") || strings.HasPrefix(typeDecl, "map[") {
+		return fmt.Sprintf("%s.%s != nil", alias, prop.GoName)
+	}
+	return ""
+}
+
+// absenceExpr returns the expression reporting whether the property is absent.
+// It returns an empty string if the property is always present.
+func absenceExpr(alias string, prop Property) string {
+	if present := presenceExpr(alias, prop); present != "" {
+		return strings.Replace(present, "!=", "==", 1)
+	}
+	return ""
+}
+
+// valueExpr returns the expression for the property value, dereferencing pointers.
+func valueExpr(alias string, prop Property) string {
+	if prop.IsPointerType() {
+		return fmt.Sprintf("*%s.%s", alias, prop.GoName)
+	}
+	return fmt.Sprintf("%s.%s", alias, prop.GoName)
+}
+
+// literalKind returns the kind of Go literal the property can be compared with:
+// string, int, float or bool. It returns an empty string for other types.
+func literalKind(prop Property) string {
+	oapi := prop.Schema.OpenAPISchema
+	if oapi == nil {
+		return ""
+	}
+	typeDecl := strings.TrimPrefix(prop.Schema.TypeDecl(), "*")
+	if typeDecl == "time.Time" || (!isPrimitiveType(typeDecl) && len(oapi.Enum) == 0) {
+		return ""
+	}
+
+	var types []string
+	for _, t := range oapi.Type {
+		if t != "null" {
+			types = append(types, t)
+		}
+	}
+	if len(types) != 1 {
+		return ""
+	}
+
+	switch types[0] {
+	case "string":
+		return "string"
+	case "integer":
+		return "int"
+	case "number":
+		return "float"
+	case "boolean":
+		return "bool"
+	}
+	return ""
+}
+
+// literalFor renders the const/enum value as a Go literal comparable with the property.
+func literalFor(prop Property, node *yaml.Node) (string, bool) {
+	if node == nil {
+		return "", false
+	}
+	tag := node.ShortTag()
+	switch literalKind(prop) {
+	case "string":
+		if tag == "!!str" {
+			return strconv.Quote(node.Value), true
+		}
+	case "int":
+		if tag == "!!int" {
+			return node.Value, true
+		}
+	case "float":
+		if tag == "!!int" || tag == "!!float" {
+			return node.Value, true
+		}
+	case "bool":
+		if tag == "!!bool" {
+			return node.Value, true
+		}
+	}
+	return "", false
+}
+
+// equalExpr compares the value with a literal, using the value directly for booleans.
+func equalExpr(value, lit string) string {
+	switch lit {
+	case "true":
+		return value
+	case "false":
+		return "!" + value
+	}
+	return fmt.Sprintf("%s == %s", value, lit)
+}
+
+func enumExpr(prop Property, value string, enum []*yaml.Node) (string, bool) {
+	var terms []string
+	for _, v := range enum {
+		lit, ok := literalFor(prop, v)
+		if !ok {
+			return "", false
+		}
+		terms = append(terms, equalExpr(value, lit))
+	}
+	return "(" + strings.Join(terms, " || ") + ")", true
+}
+
+// subschemaTags returns the validation tags for the constraints of a property subschema.
+// Subschemas usually omit the type, so it's taken from the property.
+func subschemaTags(prop Property, sub *base.Schema) string {
+	oapi := prop.Schema.OpenAPISchema
+	if oapi == nil || literalKind(prop) == "" {
+		return ""
+	}
+
+	typed := *sub
+	if len(typed.Type) == 0 {
+		typed.Type = oapi.Type
+		typed.Format = oapi.Format
+	}
+
+	var tags []string
+	for _, tag := range newConstraints(&typed, ConstraintsContext{}).ValidationTags {
+		if tag != "required" && tag != "omitempty" {
+			tags = append(tags, tag)
+		}
+	}
+	return strings.Join(tags, ",")
+}
+
+func indentLines(lines []string) []string {
+	res := make([]string, len(lines))
+	for i, l := range lines {
+		res[i] = "    " + l
+	}
+	return res
+}
+
+// mergeConditionals merges the conditional keywords of two allOf elements into result.
+// If both have an if subschema, the second one is nested into the then and else branches
+// of the first one, so both are evaluated.
+func mergeConditionals(result, s1, s2 *base.Schema) {
+	switch {
+	case s2.If == nil || s1.If == s2.If:
+		result.If, result.Then, result.Else = s1.If, s1.Then, s1.Else
+	case s1.If == nil:
+		result.If, result.Then, result.Else = s2.If, s2.Then, s2.Else
+	default:
+		nested := base.CreateSchemaProxy(&base.Schema{If: s2.If, Then: s2.Then, Else: s2.Else})
+		result.If = s1.If
+		result.Then = allOfProxy(s1.Then, nested)
+		result.Else = allOfProxy(s1.Else, nested)
+	}
+
+	for _, src := range []*base.Schema{s1, s2} {
+		for name, required := range src.DependentRequired.FromOldest() {
+			if result.DependentRequired == nil {
+				result.DependentRequired = orderedmap.New[string, []string]()
+			}
+			merged, _ := result.DependentRequired.Get(name)
+			for _, r := range required {
+				if !slices.Contains(merged, r) {
+					merged = append(merged, r)
+				}
+			}
+			result.DependentRequired.Set(name, merged)
+		}
+
+		for name, proxy := range src.DependentSchemas.FromOldest() {
+			if result.DependentSchemas == nil {
+				result.DependentSchemas = orderedmap.New[string, *base.SchemaProxy]()
+			}
+			if existing, ok := result.DependentSchemas.Get(name); ok && existing != proxy {
+				proxy = allOfProxy(existing, proxy)
+			}
+			result.DependentSchemas.Set(name, proxy)
+		}
+	}
+}
+
+func allOfProxy(proxies ...*base.SchemaProxy) *base.SchemaProxy {
+	var allOf []*base.SchemaProxy
+	for _, p := range proxies {
+		if p != nil {
+			allOf = append(allOf, p)
+		}
+	}
+	return base.CreateSchemaProxy(&base.Schema{AllOf: allOf})
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionals(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		SkipPrune:   true,
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	code := generateCode(t, readTestdata(t, "conditionals.yml"), cfg).GetCombined()
+
+	t.Run("dependentRequired", func(t *testing.T) {
+		assert.Contains(t, code, `errors = errors.Add("Expiry", "is required when cardNumber is present")`)
+		assert.Contains(t, code, `errors = errors.Add("Cvv", "is required when cardNumber is present")`)
+	})
+
+	t.Run("dependentSchemas", func(t *testing.T) {
+		assert.Contains(t, code, `errors = errors.Add("CardNumber", "is required when billingAddress is present")`)
+		assert.Contains(t, code, `if err := typesValidator.Var(*p.CardNumber, "min=12"); err != nil {`)
+	})
+
+	t.Run("if then else", func(t *testing.T) {
+		assert.Contains(t, code, `if a.Country == "US" {`)
+		assert.Contains(t, code, `errors = errors.Add("State", "is required")`)
+		assert.Contains(t, code, `if !runtime.MatchPattern("^[0-9]{5}$", string(*a.PostalCode)) {`)
+		assert.Contains(t, code, `if err := typesValidator.Var(*a.PostalCode, "max=10"); err != nil {`)
+	})
+
+	t.Run("allOf", func(t *testing.T) {
+		assert.Contains(t, code, `if s.Country == "US" {`)
+		assert.Contains(t, code, `if s.Express != nil && *s.Express {`)
+		assert.Contains(t, code, `errors = errors.Add("Phone", "is required")`)
+	})
+}
+
+func TestConditionalsUnsupportedKeyword(t *testing.T) {
+	spec := `openapi: 3.1.0
+info:
+  title: Conditionals
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Shipment:
+      type: object
+      properties:
+        kind:
+          type: string
+        tracking:
+          type: string
+      if:
+        properties:
+          kind:
+            not:
+              const: digital
+      then:
+        required: [tracking]
+`
+	var buf bytes.Buffer
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, nil)))
+
+	codes, err := Generate([]byte(spec), Configuration{PackageName: "api", SkipPrune: true})
+	require.NoError(t, err)
+
+	assert.NotContains(t, codes.GetCombined(), `errors.Add("Tracking", "is required")`)
+	assert.Contains(t, buf.String(), "level=WARN")
+	assert.Contains(t, buf.String(), "schema=Shipment")
+	assert.Contains(t, buf.String(), "keyword=properties.kind.not")
+}
+
+func TestConditionalsUnknownProperty(t *testing.T) {
+	spec := `openapi: 3.1.0
+info:
+  title: Conditionals
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Shipment:
+      type: object
+      properties:
+        kind:
+          type: string
+        tracking:
+          type: string
+      dependentRequired:
+        kind: [trackng]
+      dependentSchemas:
+        knd:
+          required: [tracking]
+      if:
+        properties:
+          kind:
+            const: physical
+      then:
+        required: [tracking, carrier]
+`
+	var buf bytes.Buffer
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, nil)))
+
+	codes, err := Generate([]byte(spec), Configuration{PackageName: "api", SkipPrune: true})
+	require.NoError(t, err)
+
+	assert.Contains(t, codes.GetCombined(), `errors = errors.Add("Tracking", "is required")`)
+	assert.Contains(t, buf.String(), "keyword=then.required.carrier")
+	assert.Contains(t, buf.String(), "keyword=dependentRequired.kind.required.trackng")
+	assert.Contains(t, buf.String(), "keyword=dependentSchemas.knd")
+	assert.NotContains(t, buf.String(), "required.tracking ")
+	assert.Equal(t, 3, strings.Count(buf.String(), "refers to an unknown property"))
+}
@@ -22,6 +22,7 @@ type ConstraintsContext struct {
 	hasNilType   bool
 	required     bool
 	specLocation SpecLocation
+	typeMappings TypeMappings
 }
 
 type Constraints struct {
@@ -115,6 +116,13 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 	isBoolean := slices.Contains(schema.Type, "boolean")
 	isString := slices.Contains(schema.Type, "string")
 
+	// A configured type mapping replaces the primitive Go type,
+	// so the length, range and pattern constraints of the primitive no longer apply.
+	_, typeMapped := appliedTypeMapping(schema, opts.typeMappings)
+	if typeMapped {
+		isInt, isFloat, isString = false, false, false
+	}
+
 	// Check if the string format converts to a non-string Go type.
 	// These formats do not support minLength/maxLength validation tags because
 	// the Go type is not a string (e.g., time.Time, uuid.UUID).
@@ -253,7 +261,7 @@ func newConstraints(schema *base.Schema, opts ConstraintsContext) Constraints {
 	}
 
 	var pattern *string
-	if schema.Pattern != "" {
+	if schema.Pattern != "" && !typeMapped {
 		pattern = &schema.Pattern
 	}
 
@@ -38,9 +38,58 @@ type EnumDefinition struct {
 }
 
 // EnumValue represents a single enum constant.
+// Description comes from the x-enum-descriptions extension.
 type EnumValue struct {
-	Name  string
-	Value string
+	Name        string
+	Value       string
+	Description string
+}
+
+// IsOpen returns true if the enum accepts values which are not listed in the spec.
+func (e EnumDefinition) IsOpen() bool {
+	return e.Schema.OpenEnum
+}
+
+// IsString returns true if the enum values are strings.
+func (e EnumDefinition) IsString() bool {
+	return e.ValueWrapper == `"`
+}
+
+// ParseTextExpr returns the strconv call parsing the string expression s
+// into the underlying type of a non-string enum.
+// It returns an empty string if the type can't be parsed with strconv.
+func (e EnumDefinition) ParseTextExpr(s string) string {
+	switch typ := e.Schema.GoType; typ {
+	case "int":
+		return fmt.Sprintf("strconv.ParseInt(%s, 10, 0)", s)
+	case "int8", "int16", "int32", "int64":
+		return fmt.Sprintf("strconv.ParseInt(%s, 10, %s)", s, strings.TrimPrefix(typ, "int"))
+	case "uint":
+		return fmt.Sprintf("strconv.ParseUint(%s, 10, 0)", s)
+	case "uint8", "uint16", "uint32", "uint64":
+		return fmt.Sprintf("strconv.ParseUint(%s, 10, %s)", s, strings.TrimPrefix(typ, "uint"))
+	case "float32", "float64":
+		return fmt.Sprintf("strconv.ParseFloat(%s, %s)", s, strings.TrimPrefix(typ, "float"))
+	case "bool":
+		return fmt.Sprintf("strconv.ParseBool(%s)", s)
+	}
+	return ""
+}
+
+// FormatTextExpr returns the strconv call formatting the enum expression v as text.
+// It's the inverse of ParseTextExpr.
+func (e EnumDefinition) FormatTextExpr(v string) string {
+	switch typ := e.Schema.GoType; typ {
+	case "int", "int8", "int16", "int32", "int64":
+		return fmt.Sprintf("strconv.AppendInt(nil, int64(%s), 10)", v)
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		return fmt.Sprintf("strconv.AppendUint(nil, uint64(%s), 10)", v)
+	case "float32", "float64":
+		return fmt.Sprintf("strconv.AppendFloat(nil, float64(%s), 'g', -1, %s)", v, strings.TrimPrefix(typ, "float"))
+	case "bool":
+		return fmt.Sprintf("strconv.AppendBool(nil, bool(%s))", v)
+	}
+	return ""
 }
 
 func createEnumsSchema(schema *base.Schema, options ParseOptions) (GoSchema, error) {
@@ -73,6 +122,28 @@ func createEnumsSchema(schema *base.Schema, options ParseOptions) (GoSchema, err
 		}
 	}
 
+	openEnum := options.OpenEnums
+	if extension, ok := exts[extEnumOpen]; ok {
+		openEnum, err = parseBooleanValue(extension)
+		if err != nil {
+			return outSchema, fmt.Errorf("invalid value for %q: %w", extEnumOpen, err)
+		}
+	}
+
+	var enumDescriptions map[string]string
+	if extension, ok := exts[extEnumDescriptions]; ok {
+		descriptions, err := extParseEnumVarNames(extension)
+		if err != nil {
+			return outSchema, fmt.Errorf("invalid value for %q: %w", extEnumDescriptions, err)
+		}
+		enumDescriptions = make(map[string]string, len(descriptions))
+		for i, description := range descriptions {
+			if i < len(enumValues) && description != "" {
+				enumDescriptions[enumValues[i]] = description
+			}
+		}
+	}
+
 	sanitizedValues := sanitizeEnumNames(enumNames, enumValues)
 
 	// If all enum values were filtered out (e.g., all were null),
@@ -83,6 +154,8 @@ func createEnumsSchema(schema *base.Schema, options ParseOptions) (GoSchema, err
 	}
 
 	outSchema.EnumValues = make(map[string]string, len(sanitizedValues))
+	outSchema.EnumDescriptions = enumDescriptions
+	outSchema.OpenEnum = openEnum
 
 	for k, v := range sanitizedValues {
 		outSchema.EnumValues[schemaNameToTypeName(k)] = v
@@ -347,7 +420,7 @@ func filterOutEnums(types []TypeDefinition, options ParseOptions) ([]EnumDefinit
 			}
 
 			options.typeTracker.registerName(name)
-			values = append(values, EnumValue{Name: name, Value: v})
+			values = append(values, EnumValue{Name: name, Value: v, Description: e.Schema.EnumDescriptions[v]})
 		}
 		slices.SortFunc(values, func(a, b EnumValue) int {
 			return strings.Compare(a.Name, b.Name)
@@ -0,0 +1,75 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenEnums(t *testing.T) {
+	generate := func(t *testing.T, openEnums bool) string {
+		t.Helper()
+		cfg := Configuration{
+			PackageName: "api",
+			SkipPrune:   true,
+			Generate: &GenerateOptions{
+				OpenEnums: openEnums,
+			},
+			Output: &Output{
+				UseSingleFile: true,
+			},
+		}
+
+		return generateCode(t, readTestdata(t, "open-enums.yml"), cfg).GetCombined()
+	}
+
+	t.Run("x-enum-open", func(t *testing.T) {
+		code := generate(t, false)
+
+		assert.Contains(t, code, "func (s Status) IsKnown() bool {")
+		assert.Contains(t, code, "func (Status) Values() []Status {")
+		assert.Contains(t, code, "func ParseStatus(value string) (Status, error) {")
+		assert.Contains(t, code, "func (s Status) MarshalText() ([]byte, error) {")
+		assert.Contains(t, code, "func (s *Status) UnmarshalText(data []byte) error {")
+		assert.NotContains(t, code, "func (s Status) Validate() error {")
+
+		assert.Contains(t, code, "func (p Priority) IsKnown() bool {")
+		assert.Contains(t, code, "func ParsePriority(value string) (Priority, error) {")
+		assert.Contains(t, code, "parsed, err := strconv.ParseInt(value, 10, 0)")
+		assert.Contains(t, code, "func (p Priority) MarshalText() ([]byte, error) {\n\treturn strconv.AppendInt(nil, int64(p), 10), nil")
+		assert.Contains(t, code, "func (p *Priority) UnmarshalText(data []byte) error {")
+		assert.Contains(t, code, "func (p Priority) MarshalJSON() ([]byte, error) {\n\treturn json.Marshal(int(p))")
+		assert.Contains(t, code, "func (p *Priority) UnmarshalJSON(data []byte) error {")
+
+		assert.Contains(t, code, "func (c Color) Validate() error {")
+		assert.NotContains(t, code, "func (c Color) IsKnown() bool {")
+	})
+
+	t.Run("open-enums option", func(t *testing.T) {
+		code := generate(t, true)
+
+		assert.Contains(t, code, "func (c Color) IsKnown() bool {")
+		assert.Contains(t, code, "func (a AccountTier) IsKnown() bool {")
+		assert.NotContains(t, code, "func (c Color) Validate() error {")
+
+		assert.Contains(t, code, "func (l Level) Validate() error {")
+		assert.NotContains(t, code, "func (l Level) IsKnown() bool {")
+	})
+
+	t.Run("x-enum-descriptions", func(t *testing.T) {
+		code := generate(t, false)
+
+		assert.Contains(t, code, "// Active The account can be used.\n\tActive Status = \"active\"")
+		assert.Contains(t, code, "// Red Warm color.\n\tRed Color = \"red\"")
+	})
+}
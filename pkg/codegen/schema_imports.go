@@ -52,18 +52,18 @@ func (im importMap) GoImports() []string {
 	return goImports
 }
 
-func collectSchemaImports(s GoSchema) (map[string]goImport, error) {
+func collectSchemaImports(s GoSchema, typeMappings TypeMappings) (map[string]goImport, error) {
 	res := map[string]goImport{}
 
 	for _, p := range s.Properties {
-		imprts, err := getOpenAPISchemaImports(p.Schema.OpenAPISchema)
+		imprts, err := getOpenAPISchemaImports(p.Schema.OpenAPISchema, typeMappings)
 		if err != nil {
 			return nil, err
 		}
 		mergeImports(res, imprts)
 	}
 
-	imprts, err := getOpenAPISchemaImports(s.OpenAPISchema)
+	imprts, err := getOpenAPISchemaImports(s.OpenAPISchema, typeMappings)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +71,7 @@ func collectSchemaImports(s GoSchema) (map[string]goImport, error) {
 	return res, nil
 }
 
-func getOpenAPISchemaImports(schema *base.Schema) (map[string]goImport, error) {
+func getOpenAPISchemaImports(schema *base.Schema, typeMappings TypeMappings) (map[string]goImport, error) {
 	res := map[string]goImport{}
 
 	if schema == nil || (schema.ParentProxy != nil && schema.ParentProxy.IsReference()) {
@@ -86,10 +86,14 @@ func getOpenAPISchemaImports(schema *base.Schema) (map[string]goImport, error) {
 		}
 	}
 
+	if gi := typeMappingImport(schema, typeMappings); gi != nil {
+		res[gi.String()] = *gi
+	}
+
 	t := schema.Type
 	if slices.Contains(t, "object") {
 		for _, v := range schema.Properties.FromOldest() {
-			imprts, err := getOpenAPISchemaImports(v.Schema())
+			imprts, err := getOpenAPISchemaImports(v.Schema(), typeMappings)
 			if err != nil {
 				return nil, err
 			}
@@ -100,7 +104,7 @@ func getOpenAPISchemaImports(schema *base.Schema) (map[string]goImport, error) {
 			return nil, nil
 		}
 		if schema.Items.IsA() && schema.Items.A != nil {
-			imprts, err := getOpenAPISchemaImports(schema.Items.A.Schema())
+			imprts, err := getOpenAPISchemaImports(schema.Items.A.Schema(), typeMappings)
 			if err != nil {
 				return nil, err
 			}
@@ -148,6 +152,16 @@ func parseGoImportExtension(v *base.Schema) (*goImport, error) {
 	return &gi, nil
 }
 
+// typeMappingImport returns the import required by a configured type mapping for the schema, if any.
+// Schemas with x-go-type or enum values are not mapped, so they don't need the import.
+func typeMappingImport(schema *base.Schema, typeMappings TypeMappings) *goImport {
+	mapping, ok := appliedTypeMapping(schema, typeMappings)
+	if !ok {
+		return nil
+	}
+	return mapping.GoImport()
+}
+
 func mergeImports(dst, src map[string]goImport) {
 	for k, v := range src {
 		dst[k] = v
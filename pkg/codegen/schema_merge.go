@@ -80,6 +80,9 @@ func createFromCombinator(schema *base.Schema, options ParseOptions) (GoSchema,
 		}
 
 		out.Properties = append(out.Properties, allOfSchema.Properties...)
+		out.PatternProperties = append(out.PatternProperties, allOfSchema.PatternProperties...)
+		out.PropertyNames = allOfSchema.PropertyNames
+		out.Conditionals = append(out.Conditionals, allOfSchema.Conditionals...)
 		additionalTypes = append(additionalTypes, allOfSchema.AdditionalTypes...)
 	}
 
@@ -190,6 +193,9 @@ func isMetadataOnlySchema(schema *base.Schema) bool {
 	if schema.Not != nil {
 		return false
 	}
+	if schema.If != nil || schema.DependentRequired != nil || schema.DependentSchemas != nil {
+		return false
+	}
 
 	// It only has metadata fields like description, title, examples, etc.
 	return true
@@ -585,6 +591,29 @@ func mergeOpenapiSchemas(s1, s2 *base.Schema) (*base.Schema, error) {
 		result.Properties.Set(k, v)
 	}
 
+	// patternProperties are merged like properties
+	for _, src := range []*base.Schema{s1, s2} {
+		if !schemaHasPatternProperties(src) {
+			continue
+		}
+		if result.PatternProperties == nil {
+			result.PatternProperties = orderedmap.New[string, *base.SchemaProxy]()
+		}
+		for k, v := range src.PatternProperties.FromOldest() {
+			result.PatternProperties.Set(k, v)
+		}
+	}
+
+	if s1.PropertyNames != nil && s2.PropertyNames != nil && s1.PropertyNames != s2.PropertyNames {
+		return nil, ErrMergingSchemasWithDifferentPropertyNames
+	}
+	result.PropertyNames = s1.PropertyNames
+	if result.PropertyNames == nil {
+		result.PropertyNames = s2.PropertyNames
+	}
+
+	mergeConditionals(result, s1, s2)
+
 	if isAdditionalPropertiesExplicitFalse(s1) || isAdditionalPropertiesExplicitFalse(s2) {
 		result.AdditionalProperties = &base.DynamicValue[*base.SchemaProxy, bool]{
 			A: nil,
@@ -637,7 +666,8 @@ func getSchemaType(schema *base.Schema) []string {
 		return schema.Type
 	}
 
-	if schema.Properties != nil {
+	if schema.Properties != nil || schema.PatternProperties != nil ||
+		schema.If != nil || schema.DependentRequired != nil || schema.DependentSchemas != nil {
 		return []string{"object"}
 	}
 
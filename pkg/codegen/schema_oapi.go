@@ -57,6 +57,7 @@ func oapiSchemaToGoType(schema *base.Schema, options ParseOptions) (GoSchema, er
 	constraints := newConstraints(schema, ConstraintsContext{
 		hasNilType:   slices.Contains(t, "null"),
 		specLocation: options.specLocation,
+		typeMappings: options.TypeMappings,
 	})
 
 	// Handle multi-type schemas (union types like ["string", "number"]).
@@ -76,6 +77,11 @@ func oapiSchemaToGoType(schema *base.Schema, options ParseOptions) (GoSchema, er
 	}
 
 	if slices.Contains(t, "array") {
+		// Arrays with prefixItems are tuples, generated as structs
+		if isTupleSchema(schema) {
+			return createTupleSchema(schema, constraints, options)
+		}
+
 		// For arrays, we'll get the type of the Items and throw a
 		// [] in front of it.
 		opts := options
@@ -123,7 +129,7 @@ func oapiSchemaToGoType(schema *base.Schema, options ParseOptions) (GoSchema, er
 		// Also skip if the ref was already registered before we started (circular reference case).
 		// If WE registered the ref (weRegisteredRef=true), we should still create the type.
 		isArrayItems := arrayType.ArrayType != nil
-		shouldCreateType := (arrayType.HasAdditionalProperties || len(arrayType.UnionElements) != 0 || len(arrayType.Properties) > 0) && arrayType.RefType == "" && !isArrayItems
+		shouldCreateType := (arrayType.HasAdditionalProperties || len(arrayType.UnionElements) != 0 || len(arrayType.Properties) > 0 || arrayType.Tuple != nil) && arrayType.RefType == "" && !isArrayItems
 		// If the ref was already registered before we started, skip creating the type
 		// (this is a circular reference and the type will be created by the original caller)
 		if shouldCreateType && itemRef != "" && !weRegisteredRef {
@@ -186,6 +192,18 @@ func oapiSchemaToGoType(schema *base.Schema, options ParseOptions) (GoSchema, er
 		}, nil
 	}
 
+	// Configured type mappings take precedence over the built-in primitive types.
+	// Enums keep their primitive type, so their values can still be declared as constants.
+	if mapping, ok := appliedTypeMapping(schema, options.TypeMappings); ok {
+		return GoSchema{
+			GoType:         mapping.Type,
+			DefineViaAlias: true,
+			Description:    schema.Description,
+			OpenAPISchema:  schema,
+			Constraints:    constraints,
+		}, nil
+	}
+
 	goType := options.DefaultIntType
 	if goType == "" {
 		goType = "int"
@@ -369,3 +387,32 @@ func isStandardUUIDLength(schema *base.Schema) bool {
 
 	return true
 }
+
+// appliedTypeMapping returns the type mapping that replaces the Go type of the schema, if any.
+// Schemas with x-go-type or enum values are not mapped.
+func appliedTypeMapping(schema *base.Schema, typeMappings TypeMappings) (TypeMapping, bool) {
+	if len(typeMappings) == 0 || len(schema.Enum) > 0 {
+		return TypeMapping{}, false
+	}
+	if schema.Extensions != nil && schema.Extensions.Value(extPropGoType) != nil {
+		return TypeMapping{}, false
+	}
+	return lookupTypeMapping(schema, typeMappings)
+}
+
+// lookupTypeMapping finds the configured type mapping for a primitive schema.
+// A "type/format" mapping is preferred, a bare "type" mapping applies only to schemas without a format.
+func lookupTypeMapping(schema *base.Schema, typeMappings TypeMappings) (TypeMapping, bool) {
+	if len(typeMappings) == 0 {
+		return TypeMapping{}, false
+	}
+	for _, typ := range schema.Type {
+		if typ == "bool" {
+			typ = "boolean"
+		}
+		if mapping, ok := typeMappings.Lookup(typ, schema.Format); ok {
+			return mapping, true
+		}
+	}
+	return TypeMapping{}, false
+}
@@ -49,6 +49,8 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 	if schema != nil &&
 		(schema.Properties == nil || schema.Properties.Len() == 0) &&
 		!schemaHasAdditionalProperties(schema) &&
+		!schemaHasPatternProperties(schema) &&
+		schema.PropertyNames == nil &&
 		schema.AllOf == nil &&
 		schema.AnyOf == nil &&
 		schema.OneOf == nil {
@@ -81,6 +83,13 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 			return GoSchema{}, err
 		}
 
+		outSchema, err = enhanceSchemaWithPatternProperties(outSchema, schema, options)
+		if err != nil {
+			return GoSchema{}, err
+		}
+		outSchema.Conditionals = newSchemaConditionals(schema)
+		warnUnsupportedConditionals(schema, outSchema.Conditionals, path)
+
 		// If the schema has no properties, and only additional properties, we will
 		// early-out here and generate a map[string]<schema> instead of an object
 		// that contains this map. We skip over anyOf/oneOf here because they can
@@ -92,8 +101,23 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 			// string to the property type. HasAdditionalProperties=false means
 			// that we won't generate custom json.Marshaler and json.Unmarshaler functions,
 			// since we don't need them for a simple map.
+			var valueType string
+			if len(outSchema.PatternProperties) > 0 {
+				// patternProperties define the value type. Values not matching any pattern
+				// are validated against additionalProperties, if it's defined as a schema.
+				valueType = patternMapValueType(outSchema).TypeDeclWithNullable()
+				if !hasAdditionalPropertiesSchema(schema) {
+					outSchema.AdditionalPropertiesType = patternMapValueType(outSchema)
+				}
+			} else {
+				if outSchema.AdditionalPropertiesType == nil {
+					// propertyNames without additionalProperties
+					outSchema.AdditionalPropertiesType = &GoSchema{GoType: "any"}
+				}
+				valueType = additionalPropertiesType(outSchema)
+			}
 			outSchema.HasAdditionalProperties = false
-			outSchema.GoType = fmt.Sprintf("map[string]%s", additionalPropertiesType(outSchema))
+			outSchema.GoType = fmt.Sprintf("map[string]%s", valueType)
 			// Store the original OpenAPI schema so downstream tools can check if this
 			// came from additionalProperties
 			outSchema.OpenAPISchema = schema
@@ -132,6 +156,7 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 					hasNilType:   hasNilTyp,
 					required:     slices.Contains(required, pName),
 					specLocation: options.specLocation,
+					typeMappings: options.TypeMappings,
 				})
 				pSchema.Constraints = constraints
 
@@ -215,6 +240,10 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 					SensitiveData: sensitiveData,
 					ParentType:    parentType,
 				}
+				if options.xmlTags {
+					prop.XMLTag = xmlFieldTag(pName, p.Schema())
+					warnXMLPrefix(p.Schema(), append(slices.Clone(path), pName))
+				}
 				outSchema.Properties = append(outSchema.Properties, prop)
 				if len(pSchema.AdditionalTypes) > 0 {
 					outSchema.AdditionalTypes = append(outSchema.AdditionalTypes, pSchema.AdditionalTypes...)
@@ -223,6 +252,12 @@ func createObjectSchema(schema *base.Schema, options ParseOptions) (GoSchema, er
 		}
 
 		fields := genFieldsFromProperties(outSchema.Properties, options)
+		if options.xmlTags {
+			warnXMLPrefix(schema, path)
+			if field, ok := xmlNameField(schema); ok {
+				fields = append([]string{field}, fields...)
+			}
+		}
 		outSchema.GoType = outSchema.createGoStruct(fields)
 
 		// Check for x-go-type-name. It behaves much like x-go-type, however, it will
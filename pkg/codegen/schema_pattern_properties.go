@@ -0,0 +1,390 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// PatternProperty describes a patternProperties entry.
+// On structs, each pattern is stored in its own map field named GoName.
+type PatternProperty struct {
+	Pattern string
+	GoName  string
+	Schema  GoSchema
+}
+
+// PatternLiteral returns the pattern as a quoted Go string.
+func (p PatternProperty) PatternLiteral() string {
+	return strconv.Quote(p.Pattern)
+}
+
+// TypeDecl returns the Go type of the map values.
+func (p PatternProperty) TypeDecl() string {
+	return p.Schema.TypeDeclWithNullable()
+}
+
+// PropertyNames holds the propertyNames constraints every object key must satisfy.
+type PropertyNames struct {
+	Pattern   string
+	Enum      []string
+	MinLength *int64
+	MaxLength *int64
+}
+
+// RuntimeLiteral returns the runtime.PropertyNames composite literal for the constraints.
+func (p PropertyNames) RuntimeLiteral() string {
+	var parts []string
+	if p.Pattern != "" {
+		parts = append(parts, "Pattern: "+strconv.Quote(p.Pattern))
+	}
+	if len(p.Enum) > 0 {
+		quoted := make([]string, len(p.Enum))
+		for i, v := range p.Enum {
+			quoted[i] = strconv.Quote(v)
+		}
+		parts = append(parts, fmt.Sprintf("Enum: []string{%s}", strings.Join(quoted, ", ")))
+	}
+	if p.MinLength != nil {
+		parts = append(parts, fmt.Sprintf("MinLength: runtime.Ptr(%d)", *p.MinLength))
+	}
+	if p.MaxLength != nil {
+		parts = append(parts, fmt.Sprintf("MaxLength: runtime.Ptr(%d)", *p.MaxLength))
+	}
+	return fmt.Sprintf("runtime.PropertyNames{%s}", strings.Join(parts, ", "))
+}
+
+func schemaHasPatternProperties(schema *base.Schema) bool {
+	return schema != nil && schema.PatternProperties != nil && schema.PatternProperties.Len() > 0
+}
+
+// schemaDisallowsAdditionalProperties returns true for `additionalProperties: false`.
+func schemaDisallowsAdditionalProperties(schema *base.Schema) bool {
+	return schema != nil && schema.AdditionalProperties != nil &&
+		schema.AdditionalProperties.IsB() && !schema.AdditionalProperties.B
+}
+
+// hasAdditionalPropertiesSchema returns true if additionalProperties is defined as a schema.
+func hasAdditionalPropertiesSchema(schema *base.Schema) bool {
+	return schema != nil && schema.AdditionalProperties != nil &&
+		schema.AdditionalProperties.IsA() && schema.AdditionalProperties.A != nil
+}
+
+// checkPattern returns an error if the pattern can't be matched by the runtime,
+// which uses RE2 syntax (no lookarounds or backreferences).
+func checkPattern(pattern string) error {
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Errorf("%w %q: %w", ErrInvalidPattern, pattern, err)
+	}
+	return nil
+}
+
+// newPropertyNames extracts the key constraints from the propertyNames schema.
+// Returns nil if there are no constraints that can be checked.
+func newPropertyNames(schema *base.Schema) (*PropertyNames, error) {
+	if schema == nil || schema.PropertyNames == nil {
+		return nil, nil
+	}
+	names := schema.PropertyNames.Schema()
+	if names == nil {
+		return nil, nil
+	}
+	if names.Pattern != "" {
+		if err := checkPattern(names.Pattern); err != nil {
+			return nil, fmt.Errorf("propertyNames: %w", err)
+		}
+	}
+
+	res := &PropertyNames{
+		Pattern:   names.Pattern,
+		MinLength: names.MinLength,
+		MaxLength: names.MaxLength,
+	}
+	for _, v := range names.Enum {
+		if v != nil {
+			res.Enum = append(res.Enum, v.Value)
+		}
+	}
+
+	if res.Pattern == "" && len(res.Enum) == 0 && res.MinLength == nil && res.MaxLength == nil {
+		return nil, nil
+	}
+	return res, nil
+}
+
+func enhanceSchemaWithPatternProperties(out GoSchema, schema *base.Schema, options ParseOptions) (GoSchema, error) {
+	if schema == nil {
+		return out, nil
+	}
+
+	names, err := newPropertyNames(schema)
+	if err != nil {
+		return GoSchema{}, err
+	}
+	out.PropertyNames = names
+
+	if !schemaHasPatternProperties(schema) {
+		return out, nil
+	}
+
+	path := options.path
+	single := schema.PatternProperties.Len() == 1
+	i := 0
+	for pattern, proxy := range schema.PatternProperties.FromOldest() {
+		i++
+		if err := checkPattern(pattern); err != nil {
+			return GoSchema{}, fmt.Errorf("patternProperties: %w", err)
+		}
+
+		suffix := "PatternProperties"
+		if !single {
+			suffix += strconv.Itoa(i)
+		}
+
+		goName := suffix
+		if s := proxy.Schema(); s != nil {
+			if extension, ok := extractExtensions(s.Extensions)[extGoName]; ok {
+				if name, err := parseString(extension); err == nil {
+					goName = name
+				}
+			}
+		}
+
+		ref := proxy.GoLow().GetReference()
+		propSchema, err := GenerateGoSchema(proxy, options.WithReference(ref).WithPath(append(path, suffix)))
+		if err != nil {
+			return GoSchema{}, fmt.Errorf("error generating type for pattern properties %q: %w", pattern, err)
+		}
+
+		// Inline objects and unions need a named type to be used as map values.
+		propSchema = nameInlineValueType(propSchema, append(path, suffix), ref, options)
+
+		out.PatternProperties = append(out.PatternProperties, PatternProperty{
+			Pattern: pattern,
+			GoName:  goName,
+			Schema:  propSchema,
+		})
+		out.AdditionalTypes = append(out.AdditionalTypes, propSchema.AdditionalTypes...)
+	}
+
+	return out, nil
+}
+
+// patternMapValueType returns the map value type for an object without properties
+// which uses patternProperties. If the patterns and additionalProperties don't share
+// the same Go type, values are stored as any.
+func patternMapValueType(s GoSchema) *GoSchema {
+	var candidates []*GoSchema
+	for i := range s.PatternProperties {
+		candidates = append(candidates, &s.PatternProperties[i].Schema)
+	}
+	if hasAdditionalPropertiesSchema(s.OpenAPISchema) && s.AdditionalPropertiesType != nil {
+		candidates = append(candidates, s.AdditionalPropertiesType)
+	}
+
+	if len(candidates) == 0 {
+		return &GoSchema{GoType: "any"}
+	}
+
+	first := candidates[0]
+	for _, c := range candidates[1:] {
+		if c.TypeDeclWithNullable() != first.TypeDeclWithNullable() {
+			return &GoSchema{GoType: "any"}
+		}
+	}
+
+	// Value constraints differ per pattern, they are validated by matching the key.
+	res := *first
+	if len(candidates) > 1 {
+		res.Constraints = Constraints{}
+	}
+	return &res
+}
+
+// HasPatternPropertiesFields returns true if the struct stores patternProperties in dedicated map fields.
+func (s GoSchema) HasPatternPropertiesFields() bool {
+	return len(s.PatternProperties) > 0 && strings.HasPrefix(s.TypeDecl(), "struct")
+}
+
+// isClosedPatternMap returns true for maps whose keys must match one of the patterns.
+func (s GoSchema) isClosedPatternMap() bool {
+	return len(s.PatternProperties) > 0 && s.isMapType() && schemaDisallowsAdditionalProperties(s.OpenAPISchema)
+}
+
+// needsDynamicPropertiesValidation returns true if the keys or values of
+// patternProperties/additionalProperties must be checked in Validate().
+func (s GoSchema) needsDynamicPropertiesValidation() bool {
+	if s.isClosedPatternMap() {
+		return true
+	}
+	if s.PropertyNames != nil && (s.isMapType() || s.HasAdditionalProperties || len(s.PatternProperties) > 0) {
+		return true
+	}
+	for _, p := range s.PatternProperties {
+		if valueNeedsValidation(&p.Schema) {
+			return true
+		}
+	}
+	if len(s.PatternProperties) > 0 && s.isMapType() && hasAdditionalPropertiesSchema(s.OpenAPISchema) {
+		return valueNeedsValidation(s.AdditionalPropertiesType)
+	}
+	return false
+}
+
+func valueNeedsValidation(s *GoSchema) bool {
+	return s != nil && (len(s.Constraints.ValidationTags) > 0 || s.NeedsValidation())
+}
+
+// generateDynamicMapValidation generates validation for maps using patternProperties or propertyNames.
+func (s GoSchema) generateDynamicMapValidation(alias, validatorVar string) string {
+	lines := []string{declareErrorsVar()}
+
+	if s.Constraints.MinProperties != nil {
+		errMsg := fmt.Sprintf(errMsgMapMinProps, *s.Constraints.MinProperties)
+		lines = append(lines, fmt.Sprintf("if len(%s) < %d {", alias, *s.Constraints.MinProperties))
+		lines = append(lines, fmt.Sprintf("    errors = errors.Add(\"Map\", fmt.Sprintf(\"%s\", len(%s)))", errMsg, alias))
+		lines = append(lines, "}")
+	}
+	if s.Constraints.MaxProperties != nil {
+		errMsg := fmt.Sprintf(errMsgMapMaxProps, *s.Constraints.MaxProperties)
+		lines = append(lines, fmt.Sprintf("if len(%s) > %d {", alias, *s.Constraints.MaxProperties))
+		lines = append(lines, fmt.Sprintf("    errors = errors.Add(\"Map\", fmt.Sprintf(\"%s\", len(%s)))", errMsg, alias))
+		lines = append(lines, "}")
+	}
+
+	var additional *GoSchema
+	if len(s.PatternProperties) == 0 || hasAdditionalPropertiesSchema(s.OpenAPISchema) {
+		additional = s.AdditionalPropertiesType
+	}
+	lines = append(lines, dynamicPropertiesLoop(alias, nil, s.PatternProperties, additional, s.isClosedPatternMap(), s.PropertyNames, validatorVar)...)
+	lines = append(lines, returnNilIfEmptyErrors())
+	return strings.Join(lines, "\n")
+}
+
+// generateDynamicStructValidation generates validation for the patternProperties
+// and additionalProperties map fields of a struct.
+// A key is stored in the field of its first matching pattern, so the values are also
+// validated against the other matching patterns with the same Go type.
+func (s GoSchema) generateDynamicStructValidation(alias, validatorVar string) []string {
+	var lines []string
+	for i, p := range s.PatternProperties {
+		var others []PatternProperty
+		for j, o := range s.PatternProperties {
+			if j != i && o.TypeDecl() == p.TypeDecl() {
+				others = append(others, o)
+			}
+		}
+		mapExpr := fmt.Sprintf("%s.%s", alias, p.GoName)
+		lines = append(lines, dynamicPropertiesLoop(mapExpr, &p.Schema, others, nil, false, s.PropertyNames, validatorVar)...)
+	}
+	if s.HasAdditionalProperties && s.PropertyNames != nil {
+		mapExpr := fmt.Sprintf("%s.AdditionalProperties", alias)
+		lines = append(lines, dynamicPropertiesLoop(mapExpr, nil, nil, nil, false, s.PropertyNames, validatorVar)...)
+	}
+	return lines
+}
+
+// dynamicPropertiesLoop generates a loop checking each key of mapExpr against propertyNames
+// and validating each value with own, and with the schema of every matching pattern.
+// Keys matching none of the patterns are validated with additional, or rejected if closed.
+// Errors are reported with the key as field.
+func dynamicPropertiesLoop(mapExpr string, own *GoSchema, patterns []PatternProperty, additional *GoSchema, closed bool, names *PropertyNames, validatorVar string) []string {
+	var body []string
+	usesValue := false
+
+	if names != nil {
+		body = append(body, fmt.Sprintf("    if err := (%s).Validate(k); err != nil {", names.RuntimeLiteral()))
+		body = append(body, "        errors = errors.Append(k, err)")
+		body = append(body, "    }")
+	}
+
+	if valueNeedsValidation(own) {
+		body = append(body, valueValidationLines(own, validatorVar, "    ")...)
+		usesValue = true
+	}
+
+	var defaultBody []string
+	if closed {
+		defaultBody = append(defaultBody, "        errors = errors.Add(k, \"is not allowed by patternProperties\")")
+	} else if valueNeedsValidation(additional) {
+		defaultBody = append(defaultBody, valueValidationLines(additional, validatorVar, "        ")...)
+		usesValue = true
+	}
+
+	// With a default branch every pattern is checked to know whether the key matched any of them.
+	trackMatch := len(patterns) > 0 && len(defaultBody) > 0
+	if trackMatch {
+		body = append(body, "    matched := false")
+	}
+	for _, p := range patterns {
+		needsValue := valueNeedsValidation(&p.Schema)
+		if !needsValue && !trackMatch {
+			continue
+		}
+		body = append(body, fmt.Sprintf("    if runtime.MatchPattern(%s, k) {", p.PatternLiteral()))
+		if trackMatch {
+			body = append(body, "        matched = true")
+		}
+		if needsValue {
+			body = append(body, valueValidationLines(&p.Schema, validatorVar, "        ")...)
+			usesValue = true
+		}
+		body = append(body, "    }")
+	}
+
+	if trackMatch {
+		body = append(body, "    if !matched {")
+		body = append(body, defaultBody...)
+		body = append(body, "    }")
+	} else if len(patterns) == 0 && len(defaultBody) > 0 {
+		for _, l := range defaultBody {
+			body = append(body, strings.TrimPrefix(l, "    "))
+		}
+	}
+
+	if len(body) == 0 {
+		return nil
+	}
+
+	var lines []string
+	if usesValue {
+		lines = append(lines, fmt.Sprintf("for k, v := range %s {", mapExpr))
+	} else {
+		lines = append(lines, fmt.Sprintf("for k := range %s {", mapExpr))
+	}
+	lines = append(lines, body...)
+	lines = append(lines, "}")
+	return lines
+}
+
+// valueValidationLines validates the map value v, reporting errors under the key k.
+func valueValidationLines(s *GoSchema, validatorVar, indent string) []string {
+	if len(s.Constraints.ValidationTags) > 0 {
+		tags := strings.Join(s.Constraints.ValidationTags, ",")
+		return []string{
+			fmt.Sprintf("%sif err := %s.Var(v, \"%s\"); err != nil {", indent, validatorVar, tags),
+			indent + "    errors = errors.Append(k, err)",
+			indent + "}",
+		}
+	}
+	return []string{
+		indent + "if validator, ok := any(v).(runtime.Validator); ok {",
+		indent + "    if err := validator.Validate(); err != nil {",
+		indent + "        errors = errors.Append(k, err)",
+		indent + "    }",
+		indent + "}",
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatternProperties(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		SkipPrune:   true,
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	code := generateCode(t, readTestdata(t, "pattern-properties.yml"), cfg).GetCombined()
+
+	t.Run("typed map", func(t *testing.T) {
+		assert.Contains(t, code, "type Extensions map[string]string")
+		assert.Contains(t, code, `if runtime.MatchPattern("^x-", k) {`)
+		assert.Contains(t, code, `errors = errors.Add(k, "is not allowed by patternProperties")`)
+	})
+
+	t.Run("overlapping patterns", func(t *testing.T) {
+		assert.Contains(t, code, `if runtime.MatchPattern("^x-", k) {
+			matched = true
+			if err := typesValidator.Var(v, "omitempty,max=10"); err != nil {`)
+		assert.Contains(t, code, `if runtime.MatchPattern("-id$", k) {
+			matched = true
+			if err := typesValidator.Var(v, "omitempty,min=3"); err != nil {`)
+		assert.Contains(t, code, `if !matched {
+			if err := typesValidator.Var(v, "omitempty,min=1"); err != nil {`)
+	})
+
+	t.Run("mixed value types", func(t *testing.T) {
+		assert.Contains(t, code, "type Mixed map[string]any")
+	})
+
+	t.Run("property names", func(t *testing.T) {
+		assert.Contains(t, code, "type Codes map[string]int")
+		assert.Contains(t, code, `(runtime.PropertyNames{Enum: []string{"a", "b"}, MaxLength: runtime.Ptr(1)}).Validate(k)`)
+	})
+
+	t.Run("struct fields", func(t *testing.T) {
+		assert.Regexp(t, "Extensions\\s+map\\[string\\]string\\s+`json:\"-\"`", code)
+		assert.Contains(t, code, "func (e *Extensible) UnmarshalJSON(data []byte) error")
+		assert.Contains(t, code, "func (e Extensible) MarshalJSON() ([]byte, error)")
+		assert.Contains(t, code, `if !runtime.MatchPattern("^x-", fieldName) {`)
+	})
+
+	t.Run("allOf", func(t *testing.T) {
+		assert.Contains(t, code, "func (m *Merged) UnmarshalJSON(data []byte) error")
+		assert.Contains(t, code, `(runtime.PropertyNames{Pattern: "^[a-z-]+$"}).Validate(k)`)
+	})
+}
+
+func TestPatternPropertiesInvalidPattern(t *testing.T) {
+	spec := `openapi: 3.1.0
+info:
+  title: Pattern properties
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Lookahead:
+      type: object
+      patternProperties:
+        "^(?!x-)":
+          type: string
+`
+	_, err := Generate([]byte(spec), Configuration{PackageName: "api", SkipPrune: true})
+	require.ErrorIs(t, err, ErrInvalidPattern)
+	assert.Contains(t, err.Error(), `"^(?!x-)"`)
+}
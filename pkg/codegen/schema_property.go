@@ -28,6 +28,7 @@ type Property struct {
 	Constraints   Constraints
 	SensitiveData *runtime.SensitiveDataConfig
 	ParentType    string // Name of the parent type (for detecting recursive references)
+	XMLTag        string // xml struct tag, set when XML codecs are configured
 }
 
 func (p Property) IsEqual(other Property) bool {
@@ -89,8 +90,9 @@ func (p Property) IsPointerType() bool {
 		}
 	}
 
-	// Arrays, maps, and objects with additional properties are not pointers
-	if p.Schema.OpenAPISchema != nil && slices.Contains(p.Schema.OpenAPISchema.Type, "array") {
+	// Arrays, maps, and objects with additional properties are not pointers.
+	// Tuples are structs, so they follow the nullable rules below.
+	if p.Schema.OpenAPISchema != nil && slices.Contains(p.Schema.OpenAPISchema.Type, "array") && !isTupleSchema(p.Schema.OpenAPISchema) {
 		return false
 	}
 	if p.Schema.OpenAPISchema != nil && slices.Contains(p.Schema.OpenAPISchema.Type, "object") {
@@ -298,6 +300,18 @@ func genFieldsFromProperties(props []Property, options ParseOptions) []string {
 			}
 		}
 
+		// Support the OpenAPI xml object for XML codecs
+		if p.XMLTag != "" {
+			switch {
+			case fieldTags["json"] == "-":
+				fieldTags["xml"] = "-"
+			case omitEmpty:
+				fieldTags["xml"] = p.XMLTag + ",omitempty"
+			default:
+				fieldTags["xml"] = p.XMLTag
+			}
+		}
+
 		// Support x-oapi-codegen-extra-tags
 		if extension, ok := p.Extensions[extPropExtraTags]; ok {
 			if tags, err := extExtraTags(extension); err == nil {
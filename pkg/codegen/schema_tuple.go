@@ -0,0 +1,237 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// TupleSchema describes an array with prefixItems.
+// It's generated as a struct with a field per position, encoded as a JSON array.
+type TupleSchema struct {
+	Elements []TupleElement
+
+	// Rest is the schema of the trailing elements, defined by items.
+	// It's nil if items is not a schema, in which case trailing elements are dropped.
+	Rest *GoSchema
+
+	// Closed is true for `items: false`, no trailing elements are allowed.
+	Closed bool
+
+	// MinItems is the number of leading elements which must be present.
+	MinItems int
+}
+
+// TupleElement is a positional element of a tuple.
+// Elements after MinItems are optional and are stored as pointers.
+type TupleElement struct {
+	Index    int
+	GoName   string
+	Schema   GoSchema
+	Required bool
+}
+
+// TypeDecl returns the Go type of the struct field.
+func (e TupleElement) TypeDecl() string {
+	typeDecl := e.Schema.TypeDecl()
+	if e.Required || strings.HasPrefix(typeDecl, "[]") || strings.HasPrefix(typeDecl, "map[") {
+		return typeDecl
+	}
+	return "*" + strings.TrimPrefix(typeDecl, "*")
+}
+
+// Position returns the 1-based position of the element.
+func (e TupleElement) Position() int {
+	return e.Index + 1
+}
+
+// IsPointer returns true if the struct field is a pointer.
+func (e TupleElement) IsPointer() bool {
+	return strings.HasPrefix(e.TypeDecl(), "*")
+}
+
+// RestTypeDecl returns the Go type of the trailing elements.
+func (t TupleSchema) RestTypeDecl() string {
+	if t.Rest == nil {
+		return ""
+	}
+	return t.Rest.TypeDeclWithNullable()
+}
+
+// Len returns the number of positional elements.
+func (t TupleSchema) Len() int {
+	return len(t.Elements)
+}
+
+// OptionalElements returns the elements which may be missing.
+func (t TupleSchema) OptionalElements() []TupleElement {
+	return t.Elements[t.MinItems:]
+}
+
+func isTupleSchema(schema *base.Schema) bool {
+	return schema != nil && len(schema.PrefixItems) > 0
+}
+
+// createTupleSchema generates the struct for an array with prefixItems.
+func createTupleSchema(schema *base.Schema, constraints Constraints, options ParseOptions) (GoSchema, error) {
+	path := options.path
+	tuple := &TupleSchema{MinItems: len(schema.PrefixItems)}
+	// Without minItems, all prefixItems are expected, as it's how tuples are commonly used.
+	if schema.MinItems != nil && int(*schema.MinItems) < len(schema.PrefixItems) {
+		tuple.MinItems = int(*schema.MinItems)
+	}
+
+	var additionalTypes []TypeDefinition
+	for i, proxy := range schema.PrefixItems {
+		name := fmt.Sprintf("Item%d", i)
+		goName := name
+		if s := proxy.Schema(); s != nil {
+			if extension, ok := extractExtensions(s.Extensions)[extGoName]; ok {
+				if n, err := parseString(extension); err == nil {
+					goName = n
+				}
+			}
+		}
+
+		ref := proxy.GoLow().GetReference()
+		elemSchema, err := GenerateGoSchema(proxy, options.WithReference(ref).WithPath(append(path, name)))
+		if err != nil {
+			return GoSchema{}, fmt.Errorf("error generating type for tuple element %d: %w", i, err)
+		}
+		elemSchema = nameInlineValueType(elemSchema, append(path, name), ref, options)
+		additionalTypes = append(additionalTypes, elemSchema.AdditionalTypes...)
+
+		tuple.Elements = append(tuple.Elements, TupleElement{
+			Index:    i,
+			GoName:   goName,
+			Schema:   elemSchema,
+			Required: i < tuple.MinItems,
+		})
+	}
+
+	if schema.Items != nil {
+		switch {
+		case schema.Items.IsB():
+			tuple.Closed = !schema.Items.B
+		case schema.Items.A != nil:
+			ref := schema.Items.A.GoLow().GetReference()
+			restSchema, err := GenerateGoSchema(schema.Items.A, options.WithReference(ref).WithPath(append(path, "Rest")))
+			if err != nil {
+				return GoSchema{}, fmt.Errorf("error generating type for tuple items: %w", err)
+			}
+			restSchema = nameInlineValueType(restSchema, append(path, "Rest"), ref, options)
+			additionalTypes = append(additionalTypes, restSchema.AdditionalTypes...)
+			tuple.Rest = &restSchema
+		}
+	}
+
+	fields := []string{"struct {"}
+	for _, e := range tuple.Elements {
+		if e.Schema.Description != "" {
+			fields = append(fields, stringToGoCommentWithPrefix(e.Schema.Description, e.GoName))
+		}
+		fields = append(fields, fmt.Sprintf("%s %s", e.GoName, e.TypeDecl()))
+	}
+	if tuple.Rest != nil {
+		fields = append(fields, fmt.Sprintf("Rest []%s", tuple.RestTypeDecl()))
+	}
+	fields = append(fields, "}")
+
+	return GoSchema{
+		GoType:          strings.Join(fields, "\n"),
+		Tuple:           tuple,
+		AdditionalTypes: additionalTypes,
+		Description:     schema.Description,
+		OpenAPISchema:   schema,
+		Constraints:     constraints,
+	}, nil
+}
+
+// needsValidation returns true if any element of the tuple needs to be validated.
+func (t TupleSchema) needsValidation() bool {
+	if t.Rest != nil && valueNeedsValidation(t.Rest) {
+		return true
+	}
+	return slices.ContainsFunc(t.Elements, func(e TupleElement) bool {
+		return valueNeedsValidation(&e.Schema)
+	})
+}
+
+// generateTupleValidation generates validation for the tuple elements.
+// Errors are reported with the field name of the element, and Rest[i] for trailing elements.
+func (s GoSchema) generateTupleValidation(alias, validatorVar string) string {
+	t := s.Tuple
+	lines := []string{declareErrorsVar()}
+
+	for _, e := range t.Elements {
+		if !valueNeedsValidation(&e.Schema) {
+			continue
+		}
+		field := fmt.Sprintf("%s.%s", alias, e.GoName)
+		checks := tupleValueValidationLines(&e.Schema, field, fmt.Sprintf("%q", e.GoName), validatorVar)
+		if e.IsPointer() {
+			lines = append(lines, fmt.Sprintf("if %s != nil {", field))
+			lines = append(lines, indentLines(checks)...)
+			lines = append(lines, "}")
+		} else {
+			lines = append(lines, checks...)
+		}
+	}
+
+	if t.Rest != nil && s.Constraints.MaxItems != nil {
+		maxRest := int(*s.Constraints.MaxItems) - t.Len()
+		errMsg := fmt.Sprintf(errMsgArrayMaxItems, *s.Constraints.MaxItems)
+		lines = append(lines, fmt.Sprintf("if len(%s.Rest) > %d {", alias, max(maxRest, 0)))
+		lines = append(lines, fmt.Sprintf("    errors = errors.Add(\"Rest\", fmt.Sprintf(\"%s\", %d+len(%s.Rest)))", errMsg, t.Len(), alias))
+		lines = append(lines, "}")
+	}
+
+	if t.Rest != nil && valueNeedsValidation(t.Rest) {
+		lines = append(lines, fmt.Sprintf("for i, item := range %s.Rest {", alias))
+		lines = append(lines, indentLines(tupleValueValidationLines(t.Rest, "item", "fmt.Sprintf(\"Rest[%d]\", i)", validatorVar))...)
+		lines = append(lines, "}")
+	}
+
+	lines = append(lines, returnNilIfEmptyErrors())
+	return strings.Join(lines, "\n")
+}
+
+// tupleValueValidationLines validates a tuple value, reporting errors under the field expression.
+// The values are present, so omitempty is dropped to validate zero values too.
+func tupleValueValidationLines(s *GoSchema, value, fieldExpr, validatorVar string) []string {
+	if len(s.Constraints.ValidationTags) > 0 {
+		var tags []string
+		for _, tag := range s.Constraints.ValidationTags {
+			if tag == "omitempty" {
+				continue
+			}
+			tags = append(tags, tag)
+		}
+		if len(tags) > 0 {
+			return []string{
+				fmt.Sprintf("if err := %s.Var(%s, \"%s\"); err != nil {", validatorVar, value, strings.Join(tags, ",")),
+				fmt.Sprintf("    errors = errors.Append(%s, err)", fieldExpr),
+				"}",
+			}
+		}
+	}
+	return []string{
+		fmt.Sprintf("if v, ok := any(%s).(runtime.Validator); ok {", value),
+		"    if err := v.Validate(); err != nil {",
+		fmt.Sprintf("        errors = errors.Append(%s, err)", fieldExpr),
+		"    }",
+		"}",
+	}
+}
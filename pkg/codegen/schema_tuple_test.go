@@ -0,0 +1,48 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTuples(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		SkipPrune:   true,
+		Output: &Output{
+			UseSingleFile: true,
+		},
+	}
+
+	code := generateCode(t, readTestdata(t, "tuples.yml"), cfg).GetCombined()
+
+	t.Run("closed tuple", func(t *testing.T) {
+		assert.Regexp(t, `type Point struct \{\s+Lat float32\s+Lng float32\s+\}`, code)
+		assert.Contains(t, code, `if err := typesValidator.Var(p.Lat, "gte=-90,lte=90"); err != nil {`)
+		assert.Contains(t, code, `return fmt.Errorf("expected at most 2 items, got %d", len(items))`)
+	})
+
+	t.Run("optional elements and rest", func(t *testing.T) {
+		assert.Regexp(t, `type Sample struct \{\s+Item0 time.Time\s+Item1 float32\s+Item2 \*string\s+Rest\s+\[\]string\s+\}`, code)
+		assert.Contains(t, code, `errors = errors.Append(fmt.Sprintf("Rest[%d]", i), err)`)
+		assert.Contains(t, code, `errors = errors.Add("Rest", fmt.Sprintf("must have at most 5 items, got %d", 3+len(s.Rest)))`)
+	})
+
+	t.Run("properties", func(t *testing.T) {
+		assert.Contains(t, code, "Start     *Point         `json:\"start,omitempty\"`")
+		assert.Contains(t, code, "Waypoints []Point        `json:\"waypoints,omitempty\"`")
+		assert.Contains(t, code, "func (r *Route_Segment) UnmarshalJSON(data []byte) error")
+		assert.Contains(t, code, "Item1 Route_Segment_Item1")
+	})
+}
@@ -70,6 +70,11 @@ func (s GoSchema) ValidateDeclWithOptions(alias string, validatorVar string, for
 		return s.generateSimpleStructValidation(alias, validatorVar)
 	}
 
+	// Tuples from prefixItems are validated by position
+	if s.Tuple != nil {
+		return s.generateTupleValidation(alias, validatorVar)
+	}
+
 	// OPTIMIZATION: If this is a struct with no unions anywhere in its tree,
 	// AND no properties need custom validation (like RefTypes),
 	// we can use the simple validate.Struct() approach instead of custom validation.
@@ -95,13 +100,18 @@ func (s GoSchema) ValidateDeclWithOptions(alias string, validatorVar string, for
 		return s.generateTypeAliasDelegation(alias)
 	}
 
+	// Handle maps with patternProperties or propertyNames
+	if s.isMapType() && s.needsDynamicPropertiesValidation() {
+		return s.generateDynamicMapValidation(alias, validatorVar)
+	}
+
 	// Handle map types (from additionalProperties)
 	if s.isMapType() && !s.hasCustomValidation() {
 		return s.generateMapValidation(alias, validatorVar)
 	}
 
 	// For other non-struct types (slices, primitives) without custom validation
-	if !s.hasCustomValidation() {
+	if !s.hasCustomValidation() && !s.needsDynamicPropertiesValidation() && !s.needsConditionalValidation() {
 		return s.generateNonStructValidation(alias, validatorVar)
 	}
 
@@ -401,6 +411,8 @@ func (s GoSchema) generateCustomPropertyValidation(alias, validatorVar string) s
 		}
 	}
 
+	lines = append(lines, s.generateDynamicStructValidation(alias, validatorVar)...)
+	lines = append(lines, s.generateConditionalValidation(alias, validatorVar)...)
 	lines = append(lines, returnNilIfEmptyErrors())
 	return strings.Join(lines, "\n")
 }
@@ -470,7 +482,7 @@ func (s GoSchema) isStructType() bool {
 // canUseSimpleStructValidation checks if we can use the optimized validator.Struct() approach
 func (s GoSchema) canUseSimpleStructValidation() bool {
 	typeDecl := s.TypeDecl()
-	if !strings.HasPrefix(typeDecl, "struct") || len(s.Properties) == 0 || s.ContainsUnions() {
+	if !strings.HasPrefix(typeDecl, "struct") || len(s.Properties) == 0 || s.ContainsUnions() || s.needsDynamicPropertiesValidation() || s.needsConditionalValidation() {
 		return false
 	}
 	// Check if any property needs custom validation
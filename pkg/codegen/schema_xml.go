@@ -0,0 +1,161 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"cmp"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// xmlFieldTag returns the xml struct tag of a property, from the OpenAPI xml object of its schema.
+// Namespaced elements are tagged "namespace name", attributes name,attr
+// and wrapped arrays name>item. Array items are named by their own xml object, if any.
+// encoding/xml doesn't write prefixes: namespaced elements declare a default namespace instead.
+func xmlFieldTag(name string, schema *base.Schema) string {
+	if schema == nil {
+		return name
+	}
+	itemName := ""
+	if slices.Contains(schema.Type, "array") && schema.Items != nil && schema.Items.IsA() {
+		if items := schema.Items.A.Schema(); items != nil && items.XML != nil {
+			itemName = items.XML.Name
+		}
+	}
+	if schema.XML == nil {
+		if itemName != "" {
+			return itemName
+		}
+		return name
+	}
+	if schema.XML.Name != "" {
+		name = schema.XML.Name
+	}
+	switch {
+	case schema.XML.Wrapped && slices.Contains(schema.Type, "array"):
+		name += ">" + cmp.Or(itemName, name)
+	case itemName != "":
+		name = itemName
+	}
+	if schema.XML.Namespace != "" {
+		name = schema.XML.Namespace + " " + name
+	}
+	if schema.XML.Attribute {
+		name += ",attr"
+	}
+	return name
+}
+
+// xmlNameField returns the XMLName field setting the root element of a struct
+// from the OpenAPI xml object, if it has a name.
+func xmlNameField(schema *base.Schema) (string, bool) {
+	if schema == nil || schema.XML == nil || schema.XML.Name == "" {
+		return "", false
+	}
+	name := schema.XML.Name
+	if schema.XML.Namespace != "" {
+		name = schema.XML.Namespace + " " + name
+	}
+	return fmt.Sprintf("XMLName xml.Name `json:\"-\" xml:%q`", name), true
+}
+
+// warnXMLPrefix logs the xml prefix of a schema, which encoding/xml can't write.
+func warnXMLPrefix(schema *base.Schema, path []string) {
+	if schema == nil || schema.XML == nil || schema.XML.Prefix == "" {
+		return
+	}
+	slog.Warn("xml prefix is ignored, encoding/xml declares a default namespace instead",
+		"schema", strings.Join(path, "."), "prefix", schema.XML.Prefix)
+}
+
+// isXMLMediaType returns true for application/xml, text/xml and +xml media types.
+func isXMLMediaType(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	return mediaType == "application/xml" || mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml")
+}
+
+// operationXMLTags returns true if the schemas of the operation get xml struct tags.
+// By default, these are operations with an XML body marshaled by a codec, see GenerateOptions.Codecs.
+// The x-xml-tags extension overrides it.
+func operationXMLTags(operation *v3high.Operation, options ParseOptions) (bool, error) {
+	if value, ok := extractExtensions(operation.Extensions)[extXMLTags]; ok {
+		return parseBooleanValue(value)
+	}
+
+	hasXMLCodec := func(content *orderedmap.Map[string, *v3high.MediaType]) bool {
+		if content == nil {
+			return false
+		}
+		for contentType := range content.KeysFromOldest() {
+			if isXMLMediaType(contentType) && options.hasCodec(contentType) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if operation.RequestBody != nil && hasXMLCodec(operation.RequestBody.Content) {
+		return true, nil
+	}
+	if operation.Responses == nil {
+		return false, nil
+	}
+	if resp := operation.Responses.Default; resp != nil && hasXMLCodec(resp.Content) {
+		return true, nil
+	}
+	for _, resp := range operation.Responses.Codes.FromOldest() {
+		if resp != nil && hasXMLCodec(resp.Content) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// findXMLRefs returns the component schemas used by operations with xml struct tags, see operationXMLTags.
+func findXMLRefs(model *v3high.Document, options ParseOptions) (map[string]bool, error) {
+	refSet := make(map[string]bool)
+	if model.Paths == nil || model.Paths.PathItems == nil {
+		return refSet, nil
+	}
+
+	for path, pathItem := range model.Paths.PathItems.FromOldest() {
+		for method, op := range pathItem.GetOperations().FromOldest() {
+			enabled, err := operationXMLTags(op, options)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing %s extension for %s %s: %w", extXMLTags, strings.ToUpper(method), path, err)
+			}
+			if !enabled {
+				continue
+			}
+			if op.RequestBody != nil {
+				collectRefFromProxy(op.RequestBody, refSet, model)
+			}
+			if op.Responses != nil {
+				if op.Responses.Default != nil {
+					collectRefFromProxy(op.Responses.Default, refSet, model)
+				}
+				for _, resp := range op.Responses.Codes.FromOldest() {
+					collectRefFromProxy(resp, refSet, model)
+				}
+			}
+		}
+	}
+
+	expandSchemaRefs(model, refSet)
+	return refSet, nil
+}
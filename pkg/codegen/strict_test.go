@@ -0,0 +1,74 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrictResponses(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Handler: &HandlerOptions{Strict: true},
+		},
+	}
+
+	codes := generateCode(t, readTestdata(t, "strict.yml"), cfg)
+	code := codes.GetCombined()
+
+	t.Run("service returns response objects", func(t *testing.T) {
+		assert.Contains(t, code, "GetPet(ctx context.Context, opts *GetPetServiceRequestOptions) (GetPetResponseObject, error)")
+		assert.Contains(t, code, "DeletePet(ctx context.Context, opts *DeletePetServiceRequestOptions) (DeletePetResponseObject, error)")
+		assert.NotContains(t, code, "GetPetResponseData")
+	})
+
+	t.Run("response variants", func(t *testing.T) {
+		assert.Regexp(t, `type GetPet200Response struct \{\s+Body\s+\*GetPetResponse\s+Headers GetPet200ResponseHeaders\s+\}`, code)
+		assert.Regexp(t, `type GetPet200ResponseHeaders struct \{\s+ETag\s+string\s+XRateLimit \*int\s+\}`, code)
+		assert.Regexp(t, `type GetPet404Response struct \{\s+Body \*GetPetErrorResponse\s+\}`, code)
+		assert.Regexp(t, `type GetPet410Response struct \{\s+\}`, code)
+		assert.Contains(t, code, "func (GetPet410Response) isGetPetResponseObject() {}")
+		assert.Contains(t, code, "func (DeletePet204Response) isDeletePetResponseObject() {}")
+	})
+
+	t.Run("range and default variants", func(t *testing.T) {
+		assert.Regexp(t, `type DeletePet4XXResponse struct \{\s+// StatusCode is the status of the response, 400 if zero.\s+StatusCode int\s+Body\s+\*DeletePetErrorResponse\s+\}`, code)
+		assert.Regexp(t, `type DeletePet5XXResponse struct \{\s+// StatusCode is the status of the response, 500 if zero.\s+StatusCode int\s+\}`, code)
+		assert.Regexp(t, `type DeletePetDefaultResponse struct \{\s+// StatusCode is the status of the response, 500 if zero.\s+StatusCode int\s+Body\s+\*DeletePetErrorResponseDefault\s+\}`, code)
+		assert.Regexp(t, `//   - DeletePet204Response\s+//   - DeletePet4XXResponse\s+//   - DeletePet5XXResponse\s+//   - DeletePetDefaultResponse\s+type DeletePetResponseObject interface`, code)
+		assert.Regexp(t, `case DeletePet5XXResponse:\s+status := 500\s+if resp.StatusCode != 0 \{\s+status = resp.StatusCode\s+\}\s+w.WriteHeader\(status\)`, code)
+		assert.Regexp(t, `case DeletePetDefaultResponse:\s+status := 500\s+if resp.StatusCode != 0 \{`, code)
+	})
+
+	t.Run("scaffold returns the success variant", func(t *testing.T) {
+		service := codes["scaffold:service"]
+		assert.Contains(t, service, "return GetPet200Response{Body: new(GetPetResponse)}, nil")
+		assert.Contains(t, service, "return DeletePet204Response{}, nil")
+	})
+
+	t.Run("not strict", func(t *testing.T) {
+		cfg := cfg
+		cfg.Generate = &GenerateOptions{Handler: &HandlerOptions{}}
+		codes, err := Generate([]byte(readTestdata(t, "strict.yml")), cfg)
+		require.NoError(t, err)
+
+		assert.NotContains(t, codes.GetCombined(), "GetPet410Response")
+		assert.NotContains(t, codes.GetCombined(), "DeletePetErrorResponseDefault")
+		assert.Contains(t, codes.GetCombined(), "(*DeletePetResponseData, error)")
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientTimeouts(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+		},
+	}
+
+	t.Run("default client timeout", func(t *testing.T) {
+		code := generateCode(t, readTestdata(t, "timeout.yml"), cfg).GetCombined()
+
+		assert.Contains(t, code, "opts = append([]runtime.APIClientOption{runtime.WithTimeout(3 * time.Second)}, opts...)")
+	})
+
+	t.Run("configured client timeout", func(t *testing.T) {
+		cfg := cfg
+		cfg.Client = &Client{Timeout: 500 * time.Millisecond}
+		code := generateCode(t, readTestdata(t, "timeout.yml"), cfg).GetCombined()
+
+		assert.Contains(t, code, "runtime.WithTimeout(500 * time.Millisecond)")
+	})
+
+	t.Run("operation timeouts", func(t *testing.T) {
+		code := generateCode(t, readTestdata(t, "timeout.yml"), cfg).GetCombined()
+
+		assert.Contains(t, code, "Timeout: 2 * time.Minute,")
+		assert.Contains(t, code, "Timeout: 90 * time.Second,")
+		assert.Equal(t, 2, strings.Count(code, "Timeout: "))
+		assert.NotContains(t, code, "context.WithTimeout(")
+		// The responses have no body to decode.
+		assert.NotContains(t, code, "bodyBytes")
+	})
+}
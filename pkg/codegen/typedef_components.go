@@ -55,7 +55,8 @@ func generateSchemaDefinitions(schemas *orderedmap.Map[string, *base.SchemaProxy
 
 	for schemaName, schemaRef := range schemas.FromOldest() {
 		ref := schemaRef.GoLow().GetReference()
-		opts := options.WithReference(ref).WithPath([]string{schemaName})
+		opts := options.WithReference(ref).WithPath([]string{schemaName}).
+			WithXMLTags(options.xmlRefs["#/components/schemas/"+schemaName])
 		goSchema, err := GenerateGoSchema(schemaRef, opts)
 		if err != nil {
 			return nil, fmt.Errorf("error converting GoSchema %s to Go type: %w", schemaName, err)
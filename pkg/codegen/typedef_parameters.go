@@ -363,9 +363,11 @@ func generateParamsTypes(objectParams []ParameterDefinition, typeName string, op
 			JsonFieldName: param.ParamName,
 			Schema:        pSchema,
 			Extensions:    exts,
+			Deprecated:    param.Spec.Deprecated,
 			Constraints: newConstraints(oapiSchema, ConstraintsContext{
 				required:     param.Required,
 				specLocation: specLocation,
+				typeMappings: options.TypeMappings,
 			}),
 		})
 		imports = append(imports, pSchema)
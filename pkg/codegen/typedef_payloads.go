@@ -27,6 +27,7 @@ import (
 // ContentType is the content type of the body.
 // Default is whether this is the default body type.
 // Encoding is the encoding options for formdata.
+// Codec is whether the body is marshaled by a runtime codec.
 type RequestBodyDefinition struct {
 	Name        string
 	Required    bool
@@ -35,6 +36,7 @@ type RequestBodyDefinition struct {
 	ContentType string
 	Default     bool
 	Encoding    map[string]RequestBodyEncoding
+	Codec       bool
 }
 
 // TypeDef returns the Go type definition for a request body
@@ -99,6 +101,8 @@ func createBodyDefinition(operationID string, body *v3high.RequestBody, options
 		tag = "Text"
 	case contentType == "text/html":
 		tag = "HTML"
+	case options.hasCodec(contentType):
+		tag = mediaTypeToCamelCase(contentType)
 	default:
 		// For unsupported content types (XML, binary, etc.), create a "Raw" body definition.
 		// This ensures opts are generated so users can access RawRequest for custom parsing.
@@ -152,6 +156,7 @@ func createBodyDefinition(operationID string, body *v3high.RequestBody, options
 		NameTag:     tag,
 		ContentType: contentType,
 		Default:     defaultBody,
+		Codec:       options.hasCodec(contentType),
 	}
 
 	if content.Encoding.Len() != 0 {
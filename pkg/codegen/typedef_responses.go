@@ -11,8 +11,11 @@
 package codegen
 
 import (
+	"cmp"
 	"fmt"
 	"iter"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -25,6 +28,9 @@ type ResponseDefinition struct {
 	Success           *ResponseContentDefinition
 	Error             *ResponseContentDefinition
 	All               map[int]*ResponseContentDefinition
+
+	// responses are the responses of strict handlers, see Responses.
+	responses []*ResponseContentDefinition
 }
 
 // ResponseContentDefinition describes Operation response.
@@ -45,10 +51,68 @@ type ResponseContentDefinition struct {
 	Ref          string
 	IsSuccess    bool
 	StatusCode   int
-	Headers      map[string]GoSchema
+	// StatusRange is the status code key of range and default responses, e.g. 4XX or default.
+	// It is empty for responses with an exact status code.
+	StatusRange string
+	Headers     map[string]GoSchema
+	// RequiredHeaders are the names of the headers marked as required.
+	RequiredHeaders map[string]bool
 	// IsRaw is true for unsupported content types (XML, form-urlencoded, etc.)
 	// that require the user to handle marshaling manually.
 	IsRaw bool
+	// Codec is true for content types marshaled by a runtime codec, see GenerateOptions.Codecs.
+	Codec bool
+}
+
+// Responses returns the responses of strict handlers: the exact status codes, then the ranges and the default response.
+// Unlike All, the ranges and the default response are not merged into the status codes they fall back to.
+func (r ResponseDefinition) Responses() []*ResponseContentDefinition {
+	if r.responses != nil {
+		return r.responses
+	}
+	res := make([]*ResponseContentDefinition, 0, len(r.All))
+	for _, status := range slices.Sorted(maps.Keys(r.All)) {
+		res = append(res, r.All[status])
+	}
+	return res
+}
+
+// StatusName returns the status code key of the response in type names, e.g. 404, 4XX or Default.
+func (r ResponseContentDefinition) StatusName() string {
+	switch {
+	case r.StatusRange == "":
+		return strconv.Itoa(r.StatusCode)
+	case strings.EqualFold(r.StatusRange, "default"):
+		return "Default"
+	}
+	return r.StatusRange
+}
+
+// HasBody returns true if the response has content.
+func (r ResponseContentDefinition) HasBody() bool {
+	return r.ResponseName != "" && r.ResponseName != "struct{}"
+}
+
+// ResponseHeaderField is a response header, as a field of the headers struct of strict handler responses.
+type ResponseHeaderField struct {
+	Name     string
+	GoName   string
+	Schema   GoSchema
+	Required bool
+}
+
+// HeaderFields returns the headers of the response, sorted by name.
+func (r ResponseContentDefinition) HeaderFields() []ResponseHeaderField {
+	res := make([]ResponseHeaderField, 0, len(r.Headers))
+	for _, name := range slices.Sorted(maps.Keys(r.Headers)) {
+		res = append(res, ResponseHeaderField{
+			Name:     name,
+			GoName:   createPropertyGoFieldName(name, nil),
+			Schema:   r.Headers[name],
+			Required: r.RequiredHeaders[name],
+		})
+	}
+	return res
 }
 
 func getOperationResponses(operationID string, responses *v3high.Responses, options ParseOptions) (*ResponseDefinition, []TypeDefinition, error) {
@@ -57,12 +121,28 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 		errorCode            int
 		fstErrorCode         int
 		fstSuccessCode       int
+		fstErrorName         string
+		fstSuccessName       string
+		variants             []*ResponseContentDefinition
 		typeDefinitions      []TypeDefinition
 		errorAliasRegistered bool // Track if we've already registered the error response alias
 	)
 
 	all := make(map[int]*ResponseContentDefinition)
 
+	// addResponse keeps a response in All, where the ranges fall back to a status code,
+	// and as a response of strict handlers, where each range stays apart with its own default status.
+	addResponse := func(rcd *ResponseContentDefinition) {
+		all[rcd.StatusCode] = rcd
+		variant := rcd
+		if rcd.StatusRange != "" {
+			v := *rcd
+			v.StatusCode = rangeStatusCode(rcd.StatusRange)
+			variant = &v
+		}
+		variants = append(variants, variant)
+	}
+
 	// If responses is nil, create a default 204 No Content response
 	if responses == nil {
 		successCode = 204
@@ -106,13 +186,15 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 			isComponentRef = strings.HasPrefix(responseRef, "#/components/")
 		}
 
-		headers, err := generateResponseHeadersSchema(response.Headers.FromOldest(), operationID, options)
+		headers, requiredHeaders, err := generateResponseHeadersSchema(response.Headers.FromOldest(), operationID, options)
 		if err != nil {
 			return nil, nil, err
 		}
 
+		statusRange := ""
 		status, err := strconv.Atoi(statusCode)
 		if err != nil {
+			statusRange = strings.ToUpper(statusCode)
 			if statusCode == "default" || strings.ToLower(statusCode) == "2xx" {
 				status = 200
 			} else if strings.ToLower(statusCode) == "4xx" || strings.ToLower(statusCode) == "5xx" {
@@ -136,11 +218,11 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 		// so we pick the first one.
 		// TODO: consider having that in parse options.
 		if fstErrorCode == 0 && !isSuccess {
-			fstErrorCode = status
+			fstErrorCode, fstErrorName = status, statusCode
 		}
 
 		if fstSuccessCode == 0 && isSuccess {
-			fstSuccessCode = status
+			fstSuccessCode, fstSuccessName = status, statusCode
 		}
 
 		var (
@@ -159,16 +241,15 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 		}
 
 		if content == nil || content.Schema == nil {
-			if isSuccess {
-				successDefinition := &ResponseContentDefinition{
-					IsSuccess:    isSuccess,
-					Description:  response.Description,
-					ResponseName: "struct{}",
-					StatusCode:   status,
-					Headers:      headers,
-				}
-				all[status] = successDefinition
-			}
+			addResponse(&ResponseContentDefinition{
+				IsSuccess:       isSuccess,
+				Description:     response.Description,
+				ResponseName:    "struct{}",
+				StatusCode:      status,
+				StatusRange:     statusRange,
+				Headers:         headers,
+				RequiredHeaders: requiredHeaders,
+			})
 			continue
 		}
 
@@ -183,7 +264,7 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 		// Include status code in path only for non-first responses to disambiguate
 		// nested types (like array items) when multiple responses have the same structure
 		pathParts := []string{operationID, typeSuffix}
-		isFirstOfKind := (isSuccess && status == fstSuccessCode) || (!isSuccess && status == fstErrorCode)
+		isFirstOfKind := (isSuccess && statusCode == fstSuccessName) || (!isSuccess && statusCode == fstErrorName)
 		if !isFirstOfKind {
 			pathParts = append(pathParts, statusCode)
 		}
@@ -200,8 +281,9 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 		}
 
 		// For raw content types (XML, YAML, etc.), override the schema to []byte
-		// since we can't automatically unmarshal these formats.
-		if isRawContentType(contentType) {
+		// since we can't automatically unmarshal these formats, unless a codec is configured.
+		hasCodec := options.hasCodec(contentType)
+		if isRawContentType(contentType) && !hasCodec {
 			contentSchema = GoSchema{
 				GoType:         "[]byte",
 				DefineViaAlias: true,
@@ -328,7 +410,7 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 				tag = "HTML"
 			}
 
-			codeName := strconv.Itoa(status)
+			codeName := cmp.Or(statusRange, strconv.Itoa(status))
 			baseName := operationID + typeSuffix
 			nameSuffixes := []string{tag, tag + codeName}
 			responseName = options.typeTracker.generateUniqueNameWithSuffixes(baseName, nameSuffixes)
@@ -382,21 +464,24 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 
 		// IsRaw is true for unsupported content types that require manual marshaling
 		// Use HasPrefix to handle content types with parameters (e.g., "text/html; charset=UTF-8")
-		isRaw := isRawContentType(contentType)
+		isRaw := isRawContentType(contentType) && !hasCodec
 
 		rcd := &ResponseContentDefinition{
-			ResponseName: responseName,
-			IsSuccess:    isSuccess,
-			Description:  response.Description,
-			Schema:       contentSchema,
-			Ref:          refType,
-			ContentType:  contentType,
-			NameTag:      tag,
-			StatusCode:   status,
-			Headers:      headers,
-			IsRaw:        isRaw,
+			ResponseName:    responseName,
+			IsSuccess:       isSuccess,
+			Description:     response.Description,
+			Schema:          contentSchema,
+			Ref:             refType,
+			ContentType:     contentType,
+			NameTag:         tag,
+			StatusCode:      status,
+			StatusRange:     statusRange,
+			Headers:         headers,
+			RequiredHeaders: requiredHeaders,
+			IsRaw:           isRaw,
+			Codec:           hasCodec,
 		}
-		all[status] = rcd
+		addResponse(rcd)
 	}
 
 	if successCode == 0 {
@@ -408,110 +493,178 @@ func getOperationResponses(operationID string, responses *v3high.Responses, opti
 			StatusCode:   successCode,
 		}
 
-		all[successCode] = successDefinition
+		addResponse(successDefinition)
 	}
 
-	if errorCode == 0 && defaultResponse != nil {
-		errorCode = 500
-		fstErrorCode = 500
-		typeSuffix := "ErrorResponse"
-		content := defaultResponse.Content.First()
-
-		ref := ""
-		contentType := "application/json"
-		var (
-			contentSchema GoSchema
-			err           error
-			refType       string
-			contentVal    *v3high.MediaType
-		)
-
-		if content != nil {
-			contentType, contentVal = content.Key(), content.Value()
-			if contentVal.Schema != nil {
-				ref = contentVal.Schema.GetReference()
+	// Strict handlers keep the default response next to the error codes, under its own type name.
+	if defaultResponse != nil && (errorCode == 0 || options.strictResponses) {
+		typeName, pathParts := operationID+"ErrorResponse", []string{operationID, "ErrorResponse"}
+		if errorCode != 0 {
+			typeName = options.typeTracker.generateUniqueNameWithSuffixes(typeName, []string{"Default"})
+			pathParts = append(pathParts, "default")
+		}
+		errorDefinition, tds, err := getDefaultResponse(operationID, typeName, pathParts, defaultResponse, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		typeDefinitions = append(typeDefinitions, tds...)
 
-				opts := options.WithReference(ref).WithPath([]string{operationID, typeSuffix})
-				contentSchema, err = GenerateGoSchema(contentVal.Schema, opts)
-				if err != nil {
-					return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
-				}
+		if errorCode == 0 {
+			errorCode = 500
+			fstErrorCode = 500
+			if errorDefinition.HasBody() {
+				all[errorCode] = errorDefinition
 			}
 		}
+		if options.strictResponses {
+			variant := *errorDefinition
+			variant.StatusRange = "default"
+			variants = append(variants, &variant)
+		}
+	}
+
+	// Error responses without a body are only kept in All
+	errorDefinition := all[fstErrorCode]
+	if errorDefinition != nil && !errorDefinition.HasBody() {
+		errorDefinition = nil
+	}
 
-		if ref != "" {
-			refType, err = refPathToGoType(ref)
+	res := &ResponseDefinition{
+		SuccessStatusCode: successCode,
+		Success:           all[successCode],
+		Error:             errorDefinition,
+		All:               all,
+	}
+	if options.strictResponses {
+		slices.SortStableFunc(variants, compareResponses)
+		res.responses = variants
+	}
+
+	return res, typeDefinitions, nil
+}
+
+// getDefaultResponse returns the default response of an operation, with a body of the given type name.
+// The response has no body when the default response has no content schema.
+func getDefaultResponse(operationID, typeName string, pathParts []string, defaultResponse *v3high.Response, options ParseOptions) (*ResponseContentDefinition, []TypeDefinition, error) {
+	var typeDefinitions []TypeDefinition
+	content := defaultResponse.Content.First()
+
+	ref := ""
+	contentType := "application/json"
+	var (
+		contentSchema GoSchema
+		err           error
+		refType       string
+		contentVal    *v3high.MediaType
+	)
+
+	if content != nil {
+		contentType, contentVal = content.Key(), content.Value()
+		if contentVal.Schema != nil {
+			ref = contentVal.Schema.GetReference()
+
+			opts := options.WithReference(ref).WithPath(pathParts)
+			contentSchema, err = GenerateGoSchema(contentVal.Schema, opts)
 			if err != nil {
-				return nil, nil, fmt.Errorf("error turning reference (%s) into a Go type: %w", ref, err)
+				return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
 			}
 		}
+	}
 
-		if !contentSchema.IsZero() {
-			if refType != "" {
-				contentSchema.RefType = refType
-			}
-			responseName := operationID + typeSuffix
-			if contentSchema.ArrayType != nil {
-				contentSchema, _ = replaceInlineTypes(contentSchema, options)
-			}
-			td := TypeDefinition{
-				Name:           responseName,
-				Schema:         contentSchema,
-				SpecLocation:   SpecLocationResponse,
-				NeedsMarshaler: needsMarshaler(contentSchema),
-			}
-			options.typeTracker.register(td, "")
-			typeDefinitions = append(typeDefinitions, td)
+	if ref != "" {
+		refType, err = refPathToGoType(ref)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error turning reference (%s) into a Go type: %w", ref, err)
+		}
+	}
 
-			// Filter out AdditionalTypes that already exist in the type tracker
-			for _, additionalType := range contentSchema.AdditionalTypes {
-				if _, exists := options.typeTracker.LookupByName(additionalType.Name); !exists {
-					typeDefinitions = append(typeDefinitions, additionalType)
-					options.typeTracker.register(additionalType, "")
-				}
-			}
+	errHeaders, errRequiredHeaders, err := generateResponseHeadersSchema(defaultResponse.Headers.FromOldest(), operationID, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating response headers schema: %w", err)
+	}
 
-			errHeaders, err := generateResponseHeadersSchema(defaultResponse.Headers.FromOldest(), operationID, options)
-			if err != nil {
-				return nil, nil, fmt.Errorf("error generating response headers schema: %w", err)
-			}
+	errorDefinition := &ResponseContentDefinition{
+		ResponseName:    "struct{}",
+		IsSuccess:       false,
+		Description:     defaultResponse.Description,
+		ContentType:     contentType,
+		StatusCode:      500,
+		Headers:         errHeaders,
+		RequiredHeaders: errRequiredHeaders,
+		Codec:           options.hasCodec(contentType),
+	}
+	if contentSchema.IsZero() {
+		return errorDefinition, nil, nil
+	}
 
-			errorDefinition := &ResponseContentDefinition{
-				ResponseName: responseName,
-				IsSuccess:    false,
-				Description:  defaultResponse.Description,
-				Schema:       contentSchema,
-				Ref:          refType,
-				ContentType:  contentType,
-				StatusCode:   errorCode,
-				Headers:      errHeaders,
-			}
-			all[errorCode] = errorDefinition
+	if refType != "" {
+		contentSchema.RefType = refType
+	}
+	if contentSchema.ArrayType != nil {
+		contentSchema, _ = replaceInlineTypes(contentSchema, options)
+	}
+	td := TypeDefinition{
+		Name:           typeName,
+		Schema:         contentSchema,
+		SpecLocation:   SpecLocationResponse,
+		NeedsMarshaler: needsMarshaler(contentSchema),
+	}
+	options.typeTracker.register(td, "")
+	typeDefinitions = append(typeDefinitions, td)
+
+	// Filter out AdditionalTypes that already exist in the type tracker
+	for _, additionalType := range contentSchema.AdditionalTypes {
+		if _, exists := options.typeTracker.LookupByName(additionalType.Name); !exists {
+			typeDefinitions = append(typeDefinitions, additionalType)
+			options.typeTracker.register(additionalType, "")
 		}
 	}
 
-	res := &ResponseDefinition{
-		SuccessStatusCode: successCode,
-		Success:           all[successCode],
-		Error:             all[fstErrorCode],
-		All:               all,
+	errorDefinition.ResponseName = typeName
+	errorDefinition.Schema = contentSchema
+	errorDefinition.Ref = refType
+	return errorDefinition, typeDefinitions, nil
+}
+
+// rangeStatusCode returns the status written for a range or default response, e.g. 400 for 4XX.
+func rangeStatusCode(statusRange string) int {
+	if n, err := strconv.Atoi(statusRange[:1]); err == nil && len(statusRange) == 3 {
+		return n * 100
 	}
+	return 500
+}
 
-	return res, typeDefinitions, nil
+// compareResponses orders the exact status codes before the ranges, and the ranges before the default response.
+func compareResponses(a, b *ResponseContentDefinition) int {
+	rank := func(r *ResponseContentDefinition) int {
+		switch {
+		case r.StatusRange == "":
+			return 0
+		case strings.EqualFold(r.StatusRange, "default"):
+			return 2
+		}
+		return 1
+	}
+	return cmp.Or(cmp.Compare(rank(a), rank(b)), cmp.Compare(a.StatusCode, b.StatusCode))
 }
 
-func generateResponseHeadersSchema(headers iter.Seq2[string, *v3high.Header], operationID string, options ParseOptions) (map[string]GoSchema, error) {
+// generateResponseHeadersSchema returns the schemas of the response headers by name, and the names of the required ones.
+func generateResponseHeadersSchema(headers iter.Seq2[string, *v3high.Header], operationID string, options ParseOptions) (map[string]GoSchema, map[string]bool, error) {
 	res := make(map[string]GoSchema)
+	required := make(map[string]bool)
 	opts := options.WithReference("").WithPath([]string{operationID, "Header"})
 
 	for hName, hdrs := range headers {
 		hSchema, err := GenerateGoSchema(hdrs.Schema, opts)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		res[hName] = hSchema
+		if hdrs.Required {
+			required[hName] = true
+		}
 	}
-	return res, nil
+	return res, required, nil
 }
 
 // isRawContentType returns true for content types that require manual marshaling
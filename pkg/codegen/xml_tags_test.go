@@ -0,0 +1,74 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package codegen
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXMLTags(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Output: &Output{
+			UseSingleFile: true,
+		},
+		Generate: &GenerateOptions{
+			Client: true,
+			Codecs: []string{"application/*xml"},
+		},
+	}
+
+	var logs bytes.Buffer
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+
+	code := generateCode(t, readTestdata(t, "xml-tags.yml"), cfg).GetCombined()
+
+	t.Run("namespaces", func(t *testing.T) {
+		assert.Regexp(t, `XMLName\s+xml\.Name\s+`+"`"+`json:"-" xml:"http://example.com/orders Order"`+"`", code)
+		assert.Regexp(t, "`"+`json:"id,omitempty" xml:"http://example.com/ids id,attr,omitempty"`+"`", code)
+		assert.Regexp(t, "`"+`json:"lines,omitempty" xml:"http://example.com/orders Lines>Line,omitempty"`+"`", code)
+		assert.Regexp(t, `XMLName\s+xml\.Name\s+`+"`"+`json:"-" xml:"http://example.com/orders Receipt"`+"`", code)
+	})
+
+	t.Run("warns about prefixes", func(t *testing.T) {
+		assert.Contains(t, logs.String(), "level=WARN")
+		assert.Contains(t, logs.String(), "schema=Order prefix=ord")
+	})
+
+	t.Run("schemas used by xml operations", func(t *testing.T) {
+		assert.Regexp(t, `XMLName\s+xml\.Name\s+`+"`"+`json:"-" xml:"Line"`+"`", code)
+		assert.Contains(t, code, `json:"sku,omitempty" xml:"sku,omitempty"`)
+		assert.Contains(t, code, `json:"number,omitempty" xml:"number,omitempty"`)
+	})
+
+	t.Run("x-xml-tags", func(t *testing.T) {
+		assert.Contains(t, code, `json:"total,omitempty" xml:"total,omitempty"`)
+		assert.Contains(t, code, `json:"lines,omitempty" xml:"Line,omitempty"`)
+		assert.Regexp(t, "Value\\s+\\*string\\s+`"+`json:"value,omitempty"`+"`", code)
+	})
+
+	t.Run("json operations", func(t *testing.T) {
+		assert.Regexp(t, "Name\\s+\\*string\\s+`"+`json:"name,omitempty"`+"`", code)
+	})
+
+	t.Run("invalid x-xml-tags", func(t *testing.T) {
+		spec := strings.Replace(readTestdata(t, "xml-tags.yml"), "x-xml-tags: true", "x-xml-tags: maybe", 1)
+		_, err := Generate([]byte(spec), cfg)
+		require.ErrorContains(t, err, "error parsing x-xml-tags extension for GET /invoices")
+	})
+}
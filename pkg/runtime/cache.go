@@ -0,0 +1,347 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CachedResponse is a response stored in a Cache.
+// ExpiresAt is the time until which it's served without revalidation, zero if it always needs one.
+// Vary holds the values of the request headers named in the Vary header of the response.
+type CachedResponse struct {
+	StatusCode int
+	Headers    http.Header
+	Content    []byte
+	ExpiresAt  time.Time
+	Vary       http.Header
+}
+
+// Cache stores the responses of GET requests, keyed by operation, request URL and credentials.
+type Cache interface {
+	// Get returns the response stored for the key, if any.
+	Get(ctx context.Context, key string) (*CachedResponse, bool)
+	// Set stores the response for the key.
+	Set(ctx context.Context, key string, resp *CachedResponse)
+	// Delete removes the response stored for the key.
+	Delete(ctx context.Context, key string)
+}
+
+// WithCache caches the responses of GET requests.
+// Fresh responses, per Cache-Control max-age, are served without sending the request.
+// Stale responses with an ETag or Last-Modified header are revalidated with
+// If-None-Match and If-Modified-Since, and served again on 304 Not Modified.
+// Responses with Cache-Control no-store or private, or with Vary: *, are not cached.
+// Responses with another Vary header are only served for requests with the same values of the named headers.
+// Requests with Cache-Control no-cache are revalidated, and with no-store skip the cache, see WithBypassCache.
+func WithCache(cache Cache) APIClientOption {
+	return func(c *Client) error {
+		c.cache = cache
+		return nil
+	}
+}
+
+type bypassCacheKey struct{}
+
+// WithBypassCache returns a context making the requests made with it skip the cache:
+// their responses are neither served from it nor stored in it. No header is added to the requests, e.g.
+//
+//	client.GetCatalog(runtime.WithBypassCache(ctx), options)
+func WithBypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+func bypassCacheFromContext(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassCacheKey{}).(bool)
+	return bypass
+}
+
+// cacheCredentialHeaders are the request headers identifying the caller,
+// so that their responses are cached separately.
+var cacheCredentialHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie"}
+
+// cacheKey returns the cache key of the request, or false if the request can't be cached.
+// The key includes a hash of the credential headers, so they don't end up in the cache.
+func cacheKey(req *http.Request) (string, bool) {
+	if req.Method != http.MethodGet || bypassCacheFromContext(req.Context()) {
+		return "", false
+	}
+	if directives := cacheControl(req.Header); directives.has("no-store") {
+		return "", false
+	}
+
+	op := ""
+	if info := OperationInfoFromContext(req.Context()); info != nil {
+		op = info.ID
+	}
+	key := op + " " + req.URL.String()
+
+	h := sha256.New()
+	credentials := false
+	for _, name := range cacheCredentialHeaders {
+		for _, value := range req.Header.Values(name) {
+			credentials = true
+			h.Write([]byte(name + ": " + value + "\n"))
+		}
+	}
+	if credentials {
+		key += " " + hex.EncodeToString(h.Sum(nil))
+	}
+	return key, true
+}
+
+// cacheVary returns the values of the request headers named in the Vary header of the response,
+// or false for Vary: *, which matches no other request.
+func cacheVary(req *http.Request, headers http.Header) (http.Header, bool) {
+	var vary http.Header
+	for _, header := range headers.Values("Vary") {
+		for name := range strings.SplitSeq(header, ",") {
+			name = http.CanonicalHeaderKey(strings.TrimSpace(name))
+			switch name {
+			case "":
+				continue
+			case "*":
+				return nil, false
+			}
+			if vary == nil {
+				vary = http.Header{}
+			}
+			vary[name] = req.Header.Values(name)
+		}
+	}
+	return vary, true
+}
+
+// varyMatches reports whether the request has the same values of the Vary headers as the cached response.
+func (r *CachedResponse) varyMatches(req *http.Request) bool {
+	for name, values := range r.Vary {
+		if !slices.Equal(values, req.Header.Values(name)) {
+			return false
+		}
+	}
+	return true
+}
+
+// cacheLookup returns the cached response for the request, and whether it's fresh.
+// A stale response with validators sets the conditional headers on the request.
+func cacheLookup(ctx context.Context, cache Cache, key string, req *http.Request) (*CachedResponse, bool) {
+	cached, ok := cache.Get(ctx, key)
+	if !ok || !cached.varyMatches(req) {
+		return nil, false
+	}
+	if !cacheControl(req.Header).has("no-cache") && time.Now().Before(cached.ExpiresAt) {
+		return cached, true
+	}
+
+	etag := cached.Headers.Get("ETag")
+	lastModified := cached.Headers.Get("Last-Modified")
+	if etag == "" && lastModified == "" {
+		return nil, false
+	}
+	if etag != "" && req.Header.Get("If-None-Match") == "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified != "" && req.Header.Get("If-Modified-Since") == "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+	return cached, false
+}
+
+// cacheStore stores a 200 response, or refreshes the cached one on 304.
+// It returns the response to use.
+func cacheStore(ctx context.Context, cache Cache, key string, req *http.Request, cached *CachedResponse, resp *Response) *Response {
+	directives := cacheControl(resp.Headers)
+	vary, cacheable := cacheVary(req, resp.Headers)
+	cacheable = cacheable && !directives.has("no-store") && !directives.has("private")
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		headers := cached.Headers.Clone()
+		for name, values := range resp.Headers {
+			if name != "Content-Length" {
+				headers[name] = values
+			}
+		}
+		refreshed := &CachedResponse{
+			StatusCode: cached.StatusCode,
+			Headers:    headers,
+			Content:    cached.Content,
+			ExpiresAt:  cacheExpiry(directives),
+			Vary:       vary,
+		}
+		if !cacheable {
+			cache.Delete(ctx, key)
+		} else {
+			cache.Set(ctx, key, refreshed)
+		}
+		return refreshed.response(req, resp.Raw)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp
+	}
+	if !cacheable {
+		cache.Delete(ctx, key)
+		return resp
+	}
+
+	expiresAt := cacheExpiry(directives)
+	if expiresAt.IsZero() && resp.Headers.Get("ETag") == "" && resp.Headers.Get("Last-Modified") == "" {
+		return resp
+	}
+	cache.Set(ctx, key, &CachedResponse{
+		StatusCode: resp.StatusCode,
+		Headers:    resp.Headers.Clone(),
+		Content:    resp.Content,
+		ExpiresAt:  expiresAt,
+		Vary:       vary,
+	})
+	return resp
+}
+
+// response returns the cached response as a Response for the request, with raw as the underlying response.
+// Without one, e.g. for fresh responses served without sending the request, raw is built from the cached response.
+func (r *CachedResponse) response(req *http.Request, raw *http.Response) *Response {
+	headers := r.Headers.Clone()
+	if raw == nil {
+		raw = &http.Response{
+			Status:        strconv.Itoa(r.StatusCode) + " " + http.StatusText(r.StatusCode),
+			StatusCode:    r.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        headers,
+			Body:          io.NopCloser(bytes.NewReader(r.Content)),
+			ContentLength: int64(len(r.Content)),
+			Request:       req,
+		}
+	}
+	return &Response{
+		Content:    r.Content,
+		StatusCode: r.StatusCode,
+		Headers:    headers,
+		Raw:        raw,
+		Operation:  OperationInfoFromContext(req.Context()),
+	}
+}
+
+// cacheExpiry returns the time until which a response is fresh, zero if it must be revalidated.
+func cacheExpiry(directives cacheDirectives) time.Time {
+	if directives.has("no-cache") {
+		return time.Time{}
+	}
+	value, ok := directives["max-age"]
+	if !ok {
+		return time.Time{}
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(time.Duration(seconds) * time.Second)
+}
+
+// cacheDirectives are the directives of a Cache-Control header, with their values.
+type cacheDirectives map[string]string
+
+func (d cacheDirectives) has(name string) bool {
+	_, ok := d[name]
+	return ok
+}
+
+func cacheControl(headers http.Header) cacheDirectives {
+	directives := cacheDirectives{}
+	for _, header := range headers.Values("Cache-Control") {
+		for part := range strings.SplitSeq(header, ",") {
+			name, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+			if name == "" {
+				continue
+			}
+			directives[strings.ToLower(name)] = strings.Trim(value, `"`)
+		}
+	}
+	return directives
+}
+
+// LRUCache is an in-memory Cache keeping the most recently used responses.
+type LRUCache struct {
+	size    int
+	mu      sync.Mutex
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type lruEntry struct {
+	key  string
+	resp *CachedResponse
+}
+
+// NewLRUCache creates an in-memory Cache holding up to size responses.
+func NewLRUCache(size int) *LRUCache {
+	return &LRUCache{
+		size:    max(size, 1),
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Get returns the response stored for the key, marking it as recently used.
+func (c *LRUCache) Get(_ context.Context, key string) (*CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*lruEntry).resp, true
+}
+
+// Set stores the response for the key, evicting the least recently used one if full.
+func (c *LRUCache) Set(_ context.Context, key string, resp *CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*lruEntry).resp = resp
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, resp: resp})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// Delete removes the response stored for the key.
+func (c *LRUCache) Delete(_ context.Context, key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cacheDoer answers with the handler and records the requests.
+type cacheDoer struct {
+	handler  func(req *http.Request) *http.Response
+	requests []*http.Request
+}
+
+func (d *cacheDoer) Do(_ context.Context, req *http.Request) (*http.Response, error) {
+	d.requests = append(d.requests, req)
+	return d.handler(req), nil
+}
+
+func cacheResponse(code int, body string, headers ...string) *http.Response {
+	resp := statusResponse(code, headers...)
+	resp.Body = io.NopCloser(strings.NewReader(body))
+	return resp
+}
+
+func newCacheClient(t *testing.T, doer HttpRequestDoer) *Client {
+	t.Helper()
+	client, err := NewAPIClient("https://example.com", WithHTTPClient(doer), WithCache(NewLRUCache(10)))
+	require.NoError(t, err)
+	return client
+}
+
+func getCatalog(t *testing.T, client *Client, editors ...RequestEditorFn) *Response {
+	t.Helper()
+	ctx := context.Background()
+	req, err := client.CreateRequest(ctx, RequestOptionsParameters{
+		RequestURL: client.GetBaseURL() + "/catalog",
+		Method:     http.MethodGet,
+		Options:    mockRequestOptions{},
+		Operation:  &OperationInfo{ID: "GetCatalog", Method: http.MethodGet, Path: "/catalog"},
+	}, editors...)
+	require.NoError(t, err)
+	resp, err := client.ExecuteRequest(ctx, req, "/catalog")
+	require.NoError(t, err)
+	return resp
+}
+
+func TestClient_cache(t *testing.T) {
+	t.Run("serves fresh responses from the cache", func(t *testing.T) {
+		doer := &cacheDoer{handler: func(*http.Request) *http.Response {
+			return cacheResponse(http.StatusOK, `["a"]`, "Cache-Control", "max-age=60")
+		}}
+		client := newCacheClient(t, doer)
+
+		first := getCatalog(t, client)
+		second := getCatalog(t, client)
+
+		assert.Len(t, doer.requests, 1)
+		assert.Equal(t, `["a"]`, string(first.Content))
+		assert.Equal(t, `["a"]`, string(second.Content))
+		assert.Equal(t, http.StatusOK, second.StatusCode)
+		require.NotNil(t, second.Raw)
+		assert.Equal(t, http.StatusOK, second.Raw.StatusCode)
+		assert.Equal(t, "max-age=60", second.Raw.Header.Get("Cache-Control"))
+		assert.Equal(t, "GetCatalog", second.Operation.ID)
+		body, err := io.ReadAll(second.Raw.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `["a"]`, string(body))
+	})
+
+	t.Run("revalidates with ETag and serves 304 from the cache", func(t *testing.T) {
+		doer := &cacheDoer{handler: func(req *http.Request) *http.Response {
+			if req.Header.Get("If-None-Match") == `"v1"` {
+				return cacheResponse(http.StatusNotModified, "", "ETag", `"v1"`)
+			}
+			return cacheResponse(http.StatusOK, `["a"]`, "ETag", `"v1"`, "Content-Type", "application/json")
+		}}
+		client := newCacheClient(t, doer)
+
+		getCatalog(t, client)
+		resp := getCatalog(t, client)
+
+		require.Len(t, doer.requests, 2)
+		assert.Empty(t, doer.requests[0].Header.Get("If-None-Match"))
+		assert.Equal(t, `"v1"`, doer.requests[1].Header.Get("If-None-Match"))
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `["a"]`, string(resp.Content))
+		assert.Equal(t, "application/json", resp.Headers.Get("Content-Type"))
+	})
+
+	t.Run("revalidates with Last-Modified", func(t *testing.T) {
+		lastModified := "Wed, 21 Oct 2015 07:28:00 GMT"
+		doer := &cacheDoer{handler: func(req *http.Request) *http.Response {
+			if req.Header.Get("If-Modified-Since") == lastModified {
+				return cacheResponse(http.StatusNotModified, "")
+			}
+			return cacheResponse(http.StatusOK, `["a"]`, "Last-Modified", lastModified)
+		}}
+		client := newCacheClient(t, doer)
+
+		getCatalog(t, client)
+		resp := getCatalog(t, client)
+
+		require.Len(t, doer.requests, 2)
+		assert.Equal(t, lastModified, doer.requests[1].Header.Get("If-Modified-Since"))
+		assert.Equal(t, `["a"]`, string(resp.Content))
+	})
+
+	t.Run("does not store no-store responses", func(t *testing.T) {
+		doer := &cacheDoer{handler: func(*http.Request) *http.Response {
+			return cacheResponse(http.StatusOK, `["a"]`, "Cache-Control", "no-store", "ETag", `"v1"`)
+		}}
+		client := newCacheClient(t, doer)
+
+		getCatalog(t, client)
+		getCatalog(t, client)
+
+		require.Len(t, doer.requests, 2)
+		assert.Empty(t, doer.requests[1].Header.Get("If-None-Match"))
+	})
+
+	t.Run("revalidates no-cache responses", func(t *testing.T) {
+		doer := &cacheDoer{handler: func(*http.Request) *http.Response {
+			return cacheResponse(http.StatusOK, `["a"]`, "Cache-Control", "no-cache, max-age=60", "ETag", `"v1"`)
+		}}
+		client := newCacheClient(t, doer)
+
+		getCatalog(t, client)
+		getCatalog(t, client)
+
+		require.Len(t, doer.requests, 2)
+		assert.Equal(t, `"v1"`, doer.requests[1].Header.Get("If-None-Match"))
+	})
+
+	t.Run("bypasses the cache with the context", func(t *testing.T) {
+		doer := &cacheDoer{handler: func(*http.Request) *http.Response {
+			return cacheResponse(http.StatusOK, `["a"]`, "Cache-Control", "max-age=60")
+		}}
+		client := newCacheClient(t, doer)
+
+		getCatalog(t, client)
+		req, err := client.CreateRequest(WithBypassCache(context.Background()), RequestOptionsParameters{
+			RequestURL: client.GetBaseURL() + "/catalog",
+			Method:     http.MethodGet,
+			Options:    mockRequestOptions{},
+			Operation:  &OperationInfo{ID: "GetCatalog", Method: http.MethodGet, Path: "/catalog"},
+		})
+		require.NoError(t, err)
+		_, err = client.ExecuteRequest(req.Context(), req, "/catalog")
+		require.NoError(t, err)
+
+		require.Len(t, doer.requests, 2)
+		assert.Empty(t, doer.requests[1].Header.Get("Cache-Control"))
+	})
+
+	t.Run("caches per credentials", func(t *testing.T) {
+		doer := &cacheDoer{handler: func(req *http.Request) *http.Response {
+			return cacheResponse(http.StatusOK, req.Header.Get("Authorization"), "Cache-Control", "max-age=60")
+		}}
+		client := newCacheClient(t, doer)
+		withAuth := func(token string) RequestEditorFn {
+			return func(_ context.Context, req *http.Request) error {
+				req.Header.Set("Authorization", token)
+				return nil
+			}
+		}
+
+		assert.Equal(t, "alice", string(getCatalog(t, client, withAuth("alice")).Content))
+		assert.Equal(t, "bob", string(getCatalog(t, client, withAuth("bob")).Content))
+		assert.Equal(t, "alice", string(getCatalog(t, client, withAuth("alice")).Content))
+		assert.Empty(t, string(getCatalog(t, client).Content))
+
+		assert.Len(t, doer.requests, 3)
+	})
+
+	t.Run("matches the Vary headers", func(t *testing.T) {
+		doer := &cacheDoer{handler: func(req *http.Request) *http.Response {
+			return cacheResponse(http.StatusOK, req.Header.Get("Accept-Language"), "Cache-Control", "max-age=60", "Vary", "Accept-Language")
+		}}
+		client := newCacheClient(t, doer)
+		withLanguage := func(lang string) RequestEditorFn {
+			return func(_ context.Context, req *http.Request) error {
+				req.Header.Set("Accept-Language", lang)
+				return nil
+			}
+		}
+
+		assert.Equal(t, "en", string(getCatalog(t, client, withLanguage("en")).Content))
+		assert.Equal(t, "en", string(getCatalog(t, client, withLanguage("en")).Content))
+		assert.Equal(t, "fr", string(getCatalog(t, client, withLanguage("fr")).Content))
+
+		assert.Len(t, doer.requests, 2)
+	})
+
+	t.Run("does not store private or Vary: * responses", func(t *testing.T) {
+		for _, headers := range [][]string{
+			{"Cache-Control", "private, max-age=60"},
+			{"Cache-Control", "max-age=60", "Vary", "*"},
+		} {
+			doer := &cacheDoer{handler: func(*http.Request) *http.Response {
+				return cacheResponse(http.StatusOK, `["a"]`, headers...)
+			}}
+			client := newCacheClient(t, doer)
+
+			getCatalog(t, client)
+			getCatalog(t, client)
+
+			assert.Len(t, doer.requests, 2, headers)
+		}
+	})
+
+	t.Run("does not cache other methods", func(t *testing.T) {
+		doer := &cacheDoer{handler: func(*http.Request) *http.Response {
+			return cacheResponse(http.StatusOK, `{}`, "Cache-Control", "max-age=60")
+		}}
+		client := newCacheClient(t, doer)
+
+		for range 2 {
+			req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+				RequestURL: client.GetBaseURL() + "/catalog",
+				Method:     http.MethodPost,
+				Options:    mockRequestOptions{},
+			})
+			require.NoError(t, err)
+			_, err = client.ExecuteRequest(context.Background(), req, "/catalog")
+			require.NoError(t, err)
+		}
+
+		assert.Len(t, doer.requests, 2)
+	})
+}
+
+func TestLRUCache(t *testing.T) {
+	ctx := context.Background()
+	cache := NewLRUCache(2)
+
+	cache.Set(ctx, "a", &CachedResponse{Content: []byte("a")})
+	cache.Set(ctx, "b", &CachedResponse{Content: []byte("b")})
+	_, ok := cache.Get(ctx, "a")
+	require.True(t, ok)
+
+	cache.Set(ctx, "c", &CachedResponse{Content: []byte("c")})
+
+	_, ok = cache.Get(ctx, "b")
+	assert.False(t, ok, "least recently used entry is evicted")
+	_, ok = cache.Get(ctx, "a")
+	assert.True(t, ok)
+	_, ok = cache.Get(ctx, "c")
+	assert.True(t, ok)
+
+	cache.Delete(ctx, "a")
+	_, ok = cache.Get(ctx, "a")
+	assert.False(t, ok)
+}
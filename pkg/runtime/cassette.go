@@ -0,0 +1,416 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"go.yaml.in/yaml/v4"
+)
+
+// ErrCassetteNoMatch is returned by ReplayDoer when no recorded interaction matches a request.
+var ErrCassetteNoMatch = errors.New("cassette: no recorded interaction matches request")
+
+// Cassette is a list of recorded HTTP interactions.
+// It's stored as JSON if the file name ends with .json, as YAML otherwise.
+type Cassette struct {
+	Interactions []CassetteInteraction `json:"interactions" yaml:"interactions"`
+}
+
+// CassetteInteraction is a recorded request and its response.
+// Operation is the ID of the generated client operation, empty for requests made without one.
+type CassetteInteraction struct {
+	Operation string           `json:"operation,omitempty" yaml:"operation,omitempty"`
+	Request   CassetteRequest  `json:"request" yaml:"request"`
+	Response  CassetteResponse `json:"response" yaml:"response"`
+}
+
+// CassetteRequest is a recorded request with sensitive values masked.
+// URL has its query parameters sorted and JSON bodies are stored in canonical form.
+type CassetteRequest struct {
+	Method       string      `json:"method" yaml:"method"`
+	URL          string      `json:"url" yaml:"url"`
+	Headers      http.Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body         string      `json:"body,omitempty" yaml:"body,omitempty"`
+	BodyEncoding string      `json:"bodyEncoding,omitempty" yaml:"bodyEncoding,omitempty"`
+}
+
+// CassetteResponse is a recorded response with sensitive values masked.
+// BodyEncoding is "base64" for bodies which are not valid UTF-8.
+type CassetteResponse struct {
+	StatusCode   int         `json:"statusCode" yaml:"statusCode"`
+	Headers      http.Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body         string      `json:"body,omitempty" yaml:"body,omitempty"`
+	BodyEncoding string      `json:"bodyEncoding,omitempty" yaml:"bodyEncoding,omitempty"`
+}
+
+// LoadCassette reads a cassette file.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: %w", err)
+	}
+
+	c := &Cassette{}
+	if isJSONCassette(path) {
+		err = json.Unmarshal(data, c)
+	} else {
+		err = yaml.Unmarshal(data, c)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cassette: error decoding %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Save writes the cassette to a file, creating its directory if needed.
+func (c *Cassette) Save(path string) error {
+	var (
+		data []byte
+		err  error
+	)
+	if isJSONCassette(path) {
+		data, err = json.MarshalIndent(c, "", "  ")
+	} else {
+		data, err = yaml.Marshal(c)
+	}
+	if err != nil {
+		return fmt.Errorf("cassette: error encoding %s: %w", path, err)
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	if err = os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	return nil
+}
+
+// CassetteOption configures a RecordingDoer or a ReplayDoer.
+type CassetteOption func(*cassetteConfig)
+
+type cassetteConfig struct {
+	sensitive    sensitiveData
+	matchHeaders []string
+}
+
+// WithCassetteSensitiveData masks the headers, query parameters and JSON properties with the given name,
+// using the same masking rules as x-sensitive-data. Names are case-insensitive.
+// Authorization, Proxy-Authorization, Cookie and Set-Cookie headers are always fully masked.
+func WithCassetteSensitiveData(name string, config SensitiveDataConfig) CassetteOption {
+	return func(c *cassetteConfig) {
+		c.sensitive[strings.ToLower(name)] = config
+	}
+}
+
+// WithCassetteSensitiveFields is WithCassetteSensitiveData for each name of the map,
+// e.g. the generated CassetteSensitiveData masking the properties marked with x-sensitive-data.
+func WithCassetteSensitiveFields(fields map[string]SensitiveDataConfig) CassetteOption {
+	return func(c *cassetteConfig) {
+		for name, config := range fields {
+			c.sensitive[strings.ToLower(name)] = config
+		}
+	}
+}
+
+// WithCassetteMatchHeaders adds the given request headers to the request matching.
+// By default, requests are matched by operation, method, URL and body.
+func WithCassetteMatchHeaders(names ...string) CassetteOption {
+	return func(c *cassetteConfig) {
+		for _, name := range names {
+			c.matchHeaders = append(c.matchHeaders, http.CanonicalHeaderKey(name))
+		}
+	}
+}
+
+func newCassetteConfig(opts []CassetteOption) *cassetteConfig {
+	cfg := &cassetteConfig{sensitive: newSensitiveData(nil)}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// RecordingDoer is an HttpRequestDoer sending requests with another doer
+// and recording them with their responses to a cassette file.
+// The file is rewritten after each request. It is safe for concurrent use.
+type RecordingDoer struct {
+	doer     HttpRequestDoer
+	path     string
+	cfg      *cassetteConfig
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecordingDoer returns a RecordingDoer sending requests with doer and recording them to the file at path.
+// An existing cassette file is overwritten.
+func NewRecordingDoer(doer HttpRequestDoer, path string, opts ...CassetteOption) *RecordingDoer {
+	return &RecordingDoer{doer: doer, path: path, cfg: newCassetteConfig(opts)}
+}
+
+// Do sends the request and records the interaction.
+func (d *RecordingDoer) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: error reading request body: %w", err)
+	}
+
+	resp, err := d.doer.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: error reading response body: %w", err)
+	}
+
+	interaction := CassetteInteraction{
+		Operation: cassetteOperation(ctx, req),
+		Request:   d.cfg.request(req, reqBody),
+		Response: CassetteResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    d.cfg.sensitive.maskHeader(resp.Header),
+		},
+	}
+	interaction.Response.Body, interaction.Response.BodyEncoding = encodeCassetteBody(d.cfg.sensitive.maskBody(respBody))
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.cassette.Interactions = append(d.cassette.Interactions, interaction)
+	if err = d.cassette.Save(d.path); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Cassette returns a copy of the recorded interactions.
+func (d *RecordingDoer) Cassette() Cassette {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return Cassette{Interactions: slices.Clone(d.cassette.Interactions)}
+}
+
+// ReplayDoer is an HttpRequestDoer answering requests from a cassette without sending them.
+// Requests are matched by operation, method, URL, body and the headers set with WithCassetteMatchHeaders,
+// after masking the sensitive values like when recording.
+// Each interaction is replayed once in order; when all matching interactions were replayed, the last one is repeated.
+// It is safe for concurrent use.
+type ReplayDoer struct {
+	cfg      *cassetteConfig
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// NewReplayDoer returns a ReplayDoer answering requests from the cassette file at path.
+func NewReplayDoer(path string, opts ...CassetteOption) (*ReplayDoer, error) {
+	c, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewCassetteReplayDoer(c, opts...), nil
+}
+
+// NewCassetteReplayDoer returns a ReplayDoer answering requests from the given cassette.
+func NewCassetteReplayDoer(c *Cassette, opts ...CassetteOption) *ReplayDoer {
+	return &ReplayDoer{cfg: newCassetteConfig(opts), cassette: c, used: make([]bool, len(c.Interactions))}
+}
+
+// Do returns the recorded response matching the request.
+// It returns an error wrapping ErrCassetteNoMatch if there is none.
+func (d *ReplayDoer) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: error reading request body: %w", err)
+	}
+	operation := cassetteOperation(ctx, req)
+	recorded := d.cfg.request(req, body)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	match := -1
+	for i, interaction := range d.cassette.Interactions {
+		if !d.matches(interaction, operation, recorded) {
+			continue
+		}
+		match = i
+		if !d.used[i] {
+			break
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("%w: %s %s (operation %q), %d interactions recorded",
+			ErrCassetteNoMatch, recorded.Method, recorded.URL, operation, len(d.cassette.Interactions))
+	}
+	d.used[match] = true
+
+	recordedResp := d.cassette.Interactions[match].Response
+	respBody, err := decodeCassetteBody(recordedResp.Body, recordedResp.BodyEncoding)
+	if err != nil {
+		return nil, err
+	}
+	header := recordedResp.Headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recordedResp.StatusCode, http.StatusText(recordedResp.StatusCode)),
+		StatusCode:    recordedResp.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
+
+// Unused returns the interactions which were not replayed.
+func (d *ReplayDoer) Unused() []CassetteInteraction {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var res []CassetteInteraction
+	for i, interaction := range d.cassette.Interactions {
+		if !d.used[i] {
+			res = append(res, interaction)
+		}
+	}
+	return res
+}
+
+func (d *ReplayDoer) matches(interaction CassetteInteraction, operation string, req CassetteRequest) bool {
+	recorded := interaction.Request
+	if interaction.Operation != operation || recorded.Method != req.Method || recorded.URL != req.URL {
+		return false
+	}
+	for _, name := range d.cfg.matchHeaders {
+		if !slices.Equal(recorded.Headers.Values(name), req.Headers.Values(name)) {
+			return false
+		}
+	}
+
+	body, err := decodeCassetteBody(recorded.Body, recorded.BodyEncoding)
+	if err != nil {
+		return false
+	}
+	recordedBody, _ := encodeCassetteBody(canonicalJSON(body, nil))
+	return recordedBody == req.Body
+}
+
+// request returns the masked and normalized form of a request.
+func (c *cassetteConfig) request(req *http.Request, body []byte) CassetteRequest {
+	u := *req.URL
+	query := u.Query()
+	for name, values := range query {
+		if config, ok := c.sensitive[strings.ToLower(name)]; ok {
+			for i, value := range values {
+				values[i] = MaskSensitiveString(value, config)
+			}
+		}
+	}
+	// Encode sorts the query parameters by name.
+	u.RawQuery = query.Encode()
+	u.Fragment = ""
+
+	res := CassetteRequest{
+		Method:  req.Method,
+		URL:     u.String(),
+		Headers: c.sensitive.maskHeader(req.Header),
+	}
+	res.Body, res.BodyEncoding = encodeCassetteBody(c.sensitive.maskBody(body))
+	return res
+}
+
+// canonicalJSON re-encodes a JSON body with sorted keys, applying transform to the decoded value.
+func canonicalJSON(body []byte, transform func(any) any) []byte {
+	var value any
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if len(body) == 0 || dec.Decode(&value) != nil || dec.More() {
+		return body
+	}
+	if transform != nil {
+		value = transform(value)
+	}
+	res, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+	return res
+}
+
+func encodeCassetteBody(body []byte) (string, string) {
+	if utf8.Valid(body) {
+		return string(body), ""
+	}
+	return base64.StdEncoding.EncodeToString(body), "base64"
+}
+
+func decodeCassetteBody(body, encoding string) ([]byte, error) {
+	switch encoding {
+	case "":
+		return []byte(body), nil
+	case "base64":
+		res, err := base64.StdEncoding.DecodeString(body)
+		if err != nil {
+			return nil, fmt.Errorf("cassette: error decoding body: %w", err)
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("cassette: unknown body encoding %q", encoding)
+	}
+}
+
+// readBody reads the body and replaces it with a reader over the read bytes.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+func cassetteOperation(ctx context.Context, req *http.Request) string {
+	op := OperationInfoFromContext(req.Context())
+	if op == nil {
+		op = OperationInfoFromContext(ctx)
+	}
+	if op == nil {
+		return ""
+	}
+	return op.ID
+}
+
+func isJSONCassette(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".json")
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCassette(t *testing.T) {
+	var served int
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /login", func(w http.ResponseWriter, r *http.Request) {
+		served++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		_, _ = w.Write([]byte(`{"token":"tok-123456","echo":` + string(body) + `}`))
+	})
+	mux.HandleFunc("GET /count", func(w http.ResponseWriter, r *http.Request) {
+		served++
+		_, _ = w.Write([]byte(r.URL.Query().Get("n")))
+	})
+
+	opts := []CassetteOption{
+		WithCassetteSensitiveData("password", SensitiveDataConfig{Type: MaskTypeHash}),
+		WithCassetteSensitiveData("token", SensitiveDataConfig{Type: MaskTypePartial, KeepSuffix: 2}),
+		WithCassetteSensitiveData("api_key", *NewDefaultSensitiveDataConfig()),
+	}
+
+	login := func(t *testing.T, doer HttpRequestDoer, password string) (*Response, error) {
+		t.Helper()
+		client, err := NewAPIClient("http://sandbox", WithHTTPClient(doer))
+		require.NoError(t, err)
+		req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+			RequestURL:  client.GetBaseURL() + "/login",
+			Method:      http.MethodPost,
+			Options:     mockRequestOptions{body: map[string]any{"user": "ada", "password": password}, query: map[string]any{"api_key": "k1", "b": "2", "a": "1"}},
+			ContentType: "application/json",
+			Operation:   &OperationInfo{ID: "Login", Method: http.MethodPost, Path: "/login"},
+		})
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer secret")
+		return client.ExecuteRequest(context.Background(), req, "/login")
+	}
+
+	count := func(t *testing.T, doer HttpRequestDoer, n string) (*http.Response, error) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, "http://sandbox/count?n="+n, nil)
+		require.NoError(t, err)
+		return doer.Do(context.Background(), req)
+	}
+
+	for _, name := range []string{"cassette.yaml", "cassette.json"} {
+		t.Run(name, func(t *testing.T) {
+			served = 0
+			path := filepath.Join(t.TempDir(), "fixtures", name)
+
+			recorder := NewRecordingDoer(NewHandlerDoer(mux), path, opts...)
+			resp, err := login(t, recorder, "hunter2")
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"token":"tok-123456","echo":{"user":"ada","password":"hunter2"}}`, string(resp.Content))
+			for _, n := range []string{"1", "2", "1"} {
+				_, err = count(t, recorder, n)
+				require.NoError(t, err)
+			}
+			assert.Equal(t, 4, served)
+
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			for _, secret := range []string{"hunter2", "tok-123456", "Bearer secret", "session=secret", "k1"} {
+				assert.NotContains(t, string(data), secret)
+			}
+
+			c, err := LoadCassette(path)
+			require.NoError(t, err)
+			require.Len(t, c.Interactions, 4)
+			recorded := c.Interactions[0]
+			assert.Equal(t, "Login", recorded.Operation)
+			assert.Equal(t, "http://sandbox/login?a=1&api_key=%2A%2A%2A%2A%2A%2A%2A%2A&b=2", recorded.Request.URL)
+			assert.Equal(t, "********", recorded.Request.Headers.Get("Authorization"))
+			assert.Equal(t, "********", recorded.Response.Headers.Get("Set-Cookie"))
+			assert.JSONEq(t, `{"token":"********56","echo":{"user":"ada","password":"f52fbd32b2b3b86ff88ef6c490628285f482af15ddcb29541f94bcf526a3f6c7"}}`, recorded.Response.Body)
+			assert.Empty(t, c.Interactions[1].Operation)
+
+			replayer, err := NewReplayDoer(path, opts...)
+			require.NoError(t, err)
+
+			resp, err = login(t, replayer, "hunter2")
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, "application/json", resp.Headers.Get("Content-Type"))
+			assert.Contains(t, string(resp.Content), `"token":"********56"`)
+
+			for _, n := range []string{"1", "2", "1", "1"} {
+				resp, err := count(t, replayer, n)
+				require.NoError(t, err)
+				body, _ := io.ReadAll(resp.Body)
+				assert.Equal(t, n, string(body))
+			}
+			assert.Empty(t, replayer.Unused())
+			assert.Equal(t, 4, served)
+
+			_, err = login(t, replayer, "wrong")
+			require.ErrorIs(t, err, ErrCassetteNoMatch)
+			assert.Contains(t, err.Error(), `POST http://sandbox/login?a=1&api_key=%2A%2A%2A%2A%2A%2A%2A%2A&b=2 (operation "Login")`)
+
+			_, err = count(t, replayer, "3")
+			require.ErrorIs(t, err, ErrCassetteNoMatch)
+		})
+	}
+
+	t.Run("match headers", func(t *testing.T) {
+		c := &Cassette{Interactions: []CassetteInteraction{{
+			Request:  CassetteRequest{Method: http.MethodGet, URL: "http://sandbox/count", Headers: http.Header{"X-Tenant": {"a"}}},
+			Response: CassetteResponse{StatusCode: http.StatusOK, Body: "a"},
+		}}}
+
+		req, err := http.NewRequest(http.MethodGet, "http://sandbox/count", nil)
+		require.NoError(t, err)
+		req.Header.Set("X-Tenant", "b")
+
+		_, err = NewCassetteReplayDoer(c).Do(context.Background(), req)
+		require.NoError(t, err)
+
+		_, err = NewCassetteReplayDoer(c, WithCassetteMatchHeaders("x-tenant")).Do(context.Background(), req)
+		require.ErrorIs(t, err, ErrCassetteNoMatch)
+	})
+
+	t.Run("sensitive fields", func(t *testing.T) {
+		cfg := newCassetteConfig([]CassetteOption{WithCassetteSensitiveFields(map[string]SensitiveDataConfig{
+			"Password": {Type: MaskTypeHash},
+			"pin":      {Type: MaskTypeFull},
+		})})
+		assert.Equal(t, MaskTypeHash, cfg.sensitive["password"].Type)
+		assert.Equal(t, MaskTypeFull, cfg.sensitive["pin"].Type)
+		assert.Equal(t, MaskTypeFull, cfg.sensitive["authorization"].Type)
+	})
+
+	t.Run("binary body", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "binary.yaml")
+		bin := NewHandlerDoer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte{0xff, 0x00, 0xfe})
+		}))
+
+		req, err := http.NewRequest(http.MethodPut, "http://sandbox/blob", strings.NewReader("\xff\x01"))
+		require.NoError(t, err)
+		_, err = NewRecordingDoer(bin, path).Do(context.Background(), req)
+		require.NoError(t, err)
+
+		replayer, err := NewReplayDoer(path)
+		require.NoError(t, err)
+		req, err = http.NewRequest(http.MethodPut, "http://sandbox/blob", strings.NewReader("\xff\x01"))
+		require.NoError(t, err)
+		resp, err := replayer.Do(context.Background(), req)
+		require.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, []byte{0xff, 0x00, 0xfe}, body)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := NewReplayDoer(filepath.Join(t.TempDir(), "missing.yaml"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -12,6 +12,7 @@ package runtime
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -20,6 +21,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type RequestOptions interface {
@@ -30,6 +32,9 @@ type RequestOptions interface {
 }
 
 // RequestOptionsParameters holds the parameters for creating a request.
+// Retryable is set from the x-retryable extension of the operation.
+// Idempotent is set from the x-idempotent extension, it adds an Idempotency-Key header.
+// Operation describes the operation, it's stored in the request context.
 type RequestOptionsParameters struct {
 	Options       RequestOptions
 	RequestURL    string
@@ -37,6 +42,9 @@ type RequestOptionsParameters struct {
 	ContentType   string
 	BodyEncoding  map[string]FieldEncoding
 	QueryEncoding map[string]QueryEncoding
+	Retryable     *bool
+	Idempotent    bool
+	Operation     *OperationInfo
 }
 
 // RequestEditorFn is the function signature for the RequestEditor callback function
@@ -46,11 +54,16 @@ type HttpRequestDoer interface {
 	Do(context context.Context, req *http.Request) (*http.Response, error)
 }
 
+// Response is a response read by the API client.
+// Raw is the underlying HTTP response, with its body already read into Content.
+// For responses served from the Cache without sending the request, Raw is built from the cached response.
+// Operation is the operation the request was made for, if known.
 type Response struct {
 	Content    []byte
 	StatusCode int
 	Headers    http.Header
 	Raw        *http.Response
+	Operation  *OperationInfo
 }
 
 type APIClient interface {
@@ -62,11 +75,29 @@ type APIClient interface {
 // Client is a client for making API requests.
 // BaseURL is the base URL for the API.
 // httpClient is the HTTP client to use for making requests.
+// timeout is the deadline of each call, unless the operation sets its own.
 // requestEditors is a list of callbacks for modifying requests which are generated before sending over the network.
+// deprecationObserver is called for requests to deprecated operations.
+// retryPolicy is used to retry failed requests, if set.
+// interceptors wrap the execution of each request.
+// validation configures the validation done by generated clients.
+// compression compresses request bodies, if set.
+// cache stores the responses of GET requests, if set.
+// codecs marshal bodies of non-JSON media types, DefaultCodecs if nil.
+// errorDetails configures the response details kept in ClientAPIError.
 type Client struct {
-	baseURL        string
-	httpClient     HttpRequestDoer
-	requestEditors []RequestEditorFn
+	baseURL             string
+	httpClient          HttpRequestDoer
+	timeout             time.Duration
+	requestEditors      []RequestEditorFn
+	deprecationObserver DeprecationObserver
+	retryPolicy         *RetryPolicy
+	interceptors        []Interceptor
+	validation          ClientValidation
+	compression         *requestCompression
+	cache               Cache
+	codecs              *CodecRegistry
+	errorDetails        *ErrorDetails
 }
 
 // GetBaseURL returns the base URL of the API client.
@@ -77,9 +108,15 @@ func (c *Client) GetBaseURL() string {
 // CreateRequest creates a new HTTP request with the given parameters and applies any request editors.
 // It returns the created request or an error if the request could not be created.
 func (c *Client) CreateRequest(ctx context.Context, params RequestOptionsParameters, reqEditors ...RequestEditorFn) (*http.Request, error) {
-	req, err := createRequest(ctx, params)
+	req, err := newOperationRequest(ctx, params, c.codecs)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, err
+	}
+
+	if c.compression != nil {
+		if err = c.compression.compress(req); err != nil {
+			return nil, fmt.Errorf("error compressing request body: %w", err)
+		}
 	}
 
 	if err = c.applyEditors(ctx, req, reqEditors); err != nil {
@@ -89,10 +126,65 @@ func (c *Client) CreateRequest(ctx context.Context, params RequestOptionsParamet
 	return req, nil
 }
 
-// ExecuteRequest sends the HTTP request and returns the response.
-// It records the HTTP call with latency if an HTTPCallRecorder is set.
+// ExecuteRequest sends the HTTP request through the interceptors and returns the response.
+// The operation is taken from the request context, set by CreateRequest.
+// Without one, it's described by the method and operationPath.
+// The call times out after the Timeout of the operation, or the timeout of the client.
 func (c *Client) ExecuteRequest(ctx context.Context, req *http.Request, operationPath string) (*Response, error) {
-	resp, err := c.httpClient.Do(ctx, req)
+	op := OperationInfoFromContext(req.Context())
+	if op == nil {
+		op = &OperationInfo{Method: req.Method, Path: operationPath}
+	}
+
+	if timeout := cmp.Or(op.Timeout, c.timeout); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var (
+		resp *Response
+		err  error
+	)
+	if len(c.interceptors) == 0 {
+		resp, err = c.send(ctx, req)
+	} else {
+		resp, err = chain(c.interceptors, op, c.send)(ctx, req)
+	}
+	if resp != nil && resp.Operation == nil {
+		resp.Operation = op
+	}
+	return resp, err
+}
+
+// send sends the HTTP request and reads the response body.
+// Failed requests are retried if a RetryPolicy is set.
+// Gzip and deflate encoded response bodies are decoded.
+// Responses are served from and stored in the Cache, if set.
+func (c *Client) send(ctx context.Context, req *http.Request) (*Response, error) {
+	var (
+		key    string
+		cached *CachedResponse
+	)
+	if c.cache != nil {
+		if k, ok := cacheKey(req); ok {
+			key = k
+			var fresh bool
+			if cached, fresh = cacheLookup(ctx, c.cache, key, req); fresh {
+				return cached.response(req, nil), nil
+			}
+		}
+	}
+
+	var (
+		resp *http.Response
+		err  error
+	)
+	if c.retryPolicy != nil {
+		resp, err = c.retryPolicy.do(ctx, c.httpClient, req)
+	} else {
+		resp, err = c.httpClient.Do(ctx, req)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
@@ -101,22 +193,87 @@ func (c *Client) ExecuteRequest(ctx context.Context, req *http.Request, operatio
 		return nil, nil
 	}
 
-	var bodyBytes []byte
-	if resp.Body != nil {
-		defer func() { _ = resp.Body.Close() }()
-		var err error
-		bodyBytes, err = io.ReadAll(resp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("error reading response body: %w", err)
+	res, err := ReadResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	if key != "" {
+		return cacheStore(ctx, c.cache, key, req, cached, res), nil
+	}
+	return res, nil
+}
+
+// NewRequest creates the HTTP request of an operation without a Client, using DefaultCodecs.
+// It's used by the generated New<Op>Request builders, for requests sent with any transport.
+func NewRequest(ctx context.Context, params RequestOptionsParameters, reqEditors ...RequestEditorFn) (*http.Request, error) {
+	req, err := newOperationRequest(ctx, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range reqEditors {
+		if err = r(ctx, req); err != nil {
+			return nil, fmt.Errorf("error applying request editors: %w", err)
 		}
 	}
+	return req, nil
+}
 
-	return &Response{
-		Content:    bodyBytes,
+// ReadResponse reads and closes the body of the HTTP response, decoding gzip and deflate encoded bodies.
+// It's used by the generated Parse<Op>Response parsers.
+func ReadResponse(resp *http.Response) (*Response, error) {
+	res := &Response{
 		StatusCode: resp.StatusCode,
 		Headers:    resp.Header,
 		Raw:        resp,
-	}, nil
+	}
+	if resp.Request != nil {
+		res.Operation = OperationInfoFromContext(resp.Request.Context())
+	}
+	if resp.Body == nil {
+		return res, nil
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+	if err := decompressResponse(resp); err != nil {
+		return nil, fmt.Errorf("error decoding response body: %w", err)
+	}
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+	res.Content = content
+	return res, nil
+}
+
+// newOperationRequest creates the HTTP request with the operation info, the Idempotency-Key header
+// and the retryable flag of the operation.
+func newOperationRequest(ctx context.Context, params RequestOptionsParameters, codecs *CodecRegistry) (*http.Request, error) {
+	req, err := createRequest(ctx, params, codecs)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	if params.Operation != nil {
+		req = req.WithContext(WithOperationInfo(req.Context(), params.Operation))
+	}
+
+	retryable := params.Retryable
+	if params.Idempotent {
+		setIdempotencyKey(ctx, req)
+		// The key deduplicates retries, so idempotent operations are retryable unless set otherwise.
+		if retryable == nil {
+			retryable = Ptr(true)
+		}
+	}
+
+	if retryable != nil {
+		// An explicit WithRetryable on the context takes precedence over the spec.
+		if _, ok := retryableFromContext(ctx); !ok {
+			req = req.WithContext(WithRetryable(req.Context(), *retryable))
+		}
+	}
+	return req, nil
 }
 
 // applyEditors applies all the request editors to the request.
@@ -151,6 +308,10 @@ func NewAPIClient(baseURL string, opts ...APIClientOption) (*Client, error) {
 		}
 	}
 
+	if res.httpClient == nil {
+		res.httpClient = NewHTTPClientDoer(&http.Client{})
+	}
+
 	return res, nil
 }
 
@@ -163,6 +324,15 @@ func WithHTTPClient(doer HttpRequestDoer) APIClientOption {
 	}
 }
 
+// WithTimeout sets the deadline of each call, including its retries,
+// unless the operation sets its own with x-timeout. Zero means no timeout.
+func WithTimeout(timeout time.Duration) APIClientOption {
+	return func(c *Client) error {
+		c.timeout = timeout
+		return nil
+	}
+}
+
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
 func WithRequestEditorFn(fn RequestEditorFn) APIClientOption {
@@ -173,7 +343,7 @@ func WithRequestEditorFn(fn RequestEditorFn) APIClientOption {
 }
 
 // createRequest creates a new POST request with the given URL, payload and headers.
-func createRequest(ctx context.Context, params RequestOptionsParameters) (*http.Request, error) {
+func createRequest(ctx context.Context, params RequestOptionsParameters, codecs *CodecRegistry) (*http.Request, error) {
 	options := params.Options
 
 	var (
@@ -261,6 +431,14 @@ func createRequest(ctx context.Context, params RequestOptionsParameters) (*http.
 			}
 			bodyBytes = []byte(encodedPayload)
 		default:
+			if codec, ok := codecs.Lookup(ctLower); ok {
+				bodyBytes, err = codec.Marshal(payload)
+				if err != nil {
+					return nil, fmt.Errorf("error encoding %s body: %w", contentType, err)
+				}
+				break
+			}
+
 			// Default: treat as JSON
 			bodyBytes, err = json.Marshal(payload)
 			if err != nil {
@@ -17,6 +17,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -226,6 +227,50 @@ func TestClient_ExecuteRequest(t *testing.T) {
 	}
 }
 
+func TestNewRequest(t *testing.T) {
+	editor := func(_ context.Context, req *http.Request) error {
+		req.Header.Set("X-Signature", "sig")
+		return nil
+	}
+	req, err := NewRequest(context.Background(), RequestOptionsParameters{
+		RequestURL:  "https://api.example.com/users/{id}",
+		Method:      http.MethodPost,
+		ContentType: "application/json",
+		Options: mockRequestOptions{
+			pathParams: map[string]any{"id": 1},
+			body:       map[string]string{"name": "Ada"},
+		},
+		Idempotent: true,
+		Operation:  &OperationInfo{ID: "UpdateUser", Method: http.MethodPost, Path: "/users/{id}"},
+	}, editor)
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://api.example.com/users/1", req.URL.String())
+	assert.Equal(t, "sig", req.Header.Get("X-Signature"))
+	assert.NotEmpty(t, req.Header.Get(IdempotencyKeyHeader))
+	assert.Equal(t, "UpdateUser", OperationInfoFromContext(req.Context()).ID)
+
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Ada"}`, string(body))
+}
+
+func TestReadResponse(t *testing.T) {
+	resp, err := ReadResponse(&http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"status":"ok"}`)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `{"status":"ok"}`, string(resp.Content))
+	assert.Equal(t, "application/json", resp.Headers.Get("Content-Type"))
+
+	resp, err = ReadResponse(&http.Response{StatusCode: http.StatusNoContent})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Content)
+}
+
 func TestNewAPIClient(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -268,6 +313,72 @@ func TestWithHTTPClient(t *testing.T) {
 	assert.Equal(t, mockDoer, client.httpClient)
 }
 
+// deadlineDoer records the time left before the deadline of the context of each request.
+type deadlineDoer struct {
+	left []time.Duration
+}
+
+func (d *deadlineDoer) Do(ctx context.Context, _ *http.Request) (*http.Response, error) {
+	var left time.Duration
+	if deadline, ok := ctx.Deadline(); ok {
+		left = time.Until(deadline)
+	}
+	d.left = append(d.left, left)
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+}
+
+func TestWithTimeout(t *testing.T) {
+	execute := func(t *testing.T, client *Client, op *OperationInfo) {
+		t.Helper()
+		req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+			RequestURL: "https://api.example.com/users",
+			Method:     http.MethodGet,
+			Operation:  op,
+		})
+		require.NoError(t, err)
+		_, err = client.ExecuteRequest(context.Background(), req, "/users")
+		require.NoError(t, err)
+	}
+
+	t.Run("default http client has no timeout", func(t *testing.T) {
+		client, err := NewAPIClient("https://api.example.com", WithTimeout(5*time.Second))
+		require.NoError(t, err)
+
+		doer, ok := client.httpClient.(*HTTPClientDoer)
+		require.True(t, ok)
+		assert.Zero(t, doer.Client.Timeout)
+	})
+
+	t.Run("deadline of each call", func(t *testing.T) {
+		doer := &deadlineDoer{}
+		client, err := NewAPIClient("https://api.example.com", WithTimeout(5*time.Second), WithHTTPClient(doer))
+		require.NoError(t, err)
+
+		execute(t, client, &OperationInfo{ID: "ListUsers"})
+		require.Len(t, doer.left, 1)
+		assert.InDelta(t, 5*time.Second, doer.left[0], float64(time.Second))
+	})
+
+	t.Run("operation timeout replaces it", func(t *testing.T) {
+		doer := &deadlineDoer{}
+		client, err := NewAPIClient("https://api.example.com", WithTimeout(5*time.Second), WithHTTPClient(doer))
+		require.NoError(t, err)
+
+		execute(t, client, &OperationInfo{ID: "ListUsers", Timeout: 30 * time.Second})
+		require.Len(t, doer.left, 1)
+		assert.InDelta(t, 30*time.Second, doer.left[0], float64(time.Second))
+	})
+
+	t.Run("no timeout", func(t *testing.T) {
+		doer := &deadlineDoer{}
+		client, err := NewAPIClient("https://api.example.com", WithHTTPClient(doer))
+		require.NoError(t, err)
+
+		execute(t, client, nil)
+		assert.Equal(t, []time.Duration{0}, doer.left)
+	})
+}
+
 func TestWithRequestEditorFn(t *testing.T) {
 	editor := func(ctx context.Context, req *http.Request) error { return nil }
 	client := &Client{}
@@ -0,0 +1,132 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ClientValidation configures the validation done by generated clients.
+// Request validates the request options before the request is sent.
+// Response validates the success response after decoding.
+type ClientValidation struct {
+	Request  bool
+	Response bool
+}
+
+// ClientValidationProvider is implemented by API clients which support request and response validation.
+type ClientValidationProvider interface {
+	ClientValidation() ClientValidation
+}
+
+// WithRequestValidation validates the request options before sending a request.
+// Invalid options are reported as a RequestValidationError.
+func WithRequestValidation() APIClientOption {
+	return func(c *Client) error {
+		c.validation.Request = true
+		return nil
+	}
+}
+
+// WithResponseValidation validates success responses after decoding them.
+// Invalid responses are reported as a ResponseValidationError.
+func WithResponseValidation() APIClientOption {
+	return func(c *Client) error {
+		c.validation.Response = true
+		return nil
+	}
+}
+
+// ClientValidation returns the validation enabled with WithRequestValidation and WithResponseValidation.
+func (c *Client) ClientValidation() ClientValidation {
+	return c.validation
+}
+
+// RequestValidationError is returned by generated clients when the request options are invalid.
+// The request is not sent.
+type RequestValidationError struct {
+	OperationID string
+	Errors      ValidationErrors
+}
+
+// Error implements the error interface.
+func (e *RequestValidationError) Error() string {
+	return fmt.Sprintf("invalid %s request: %s", e.OperationID, e.Errors.Error())
+}
+
+// Unwrap returns the validation errors.
+func (e *RequestValidationError) Unwrap() error {
+	return e.Errors
+}
+
+// ResponseValidationError is returned by generated clients when a decoded success response is invalid.
+type ResponseValidationError struct {
+	OperationID string
+	StatusCode  int
+	Errors      ValidationErrors
+}
+
+// Error implements the error interface.
+func (e *ResponseValidationError) Error() string {
+	return fmt.Sprintf("invalid %s response (status %d): %s", e.OperationID, e.StatusCode, e.Errors.Error())
+}
+
+// Unwrap returns the validation errors.
+func (e *ResponseValidationError) Unwrap() error {
+	return e.Errors
+}
+
+// ValidateRequest validates the request options if the API client has request validation enabled.
+// It does nothing if the client doesn't implement ClientValidationProvider.
+func ValidateRequest(client APIClient, operationID string, options any) error {
+	provider, ok := client.(ClientValidationProvider)
+	if !ok || !provider.ClientValidation().Request {
+		return nil
+	}
+	if err := validate(options); err != nil {
+		return &RequestValidationError{OperationID: operationID, Errors: toValidationErrors(err)}
+	}
+	return nil
+}
+
+// ValidateResponse validates the decoded response if the API client has response validation enabled.
+// It does nothing if the client doesn't implement ClientValidationProvider.
+func ValidateResponse(client APIClient, operationID string, statusCode int, response any) error {
+	provider, ok := client.(ClientValidationProvider)
+	if !ok || !provider.ClientValidation().Response {
+		return nil
+	}
+	if err := validate(response); err != nil {
+		return &ResponseValidationError{OperationID: operationID, StatusCode: statusCode, Errors: toValidationErrors(err)}
+	}
+	return nil
+}
+
+func validate(value any) error {
+	v, ok := value.(Validator)
+	if !ok {
+		return nil
+	}
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil
+	}
+	return v.Validate()
+}
+
+func toValidationErrors(err error) ValidationErrors {
+	var ves ValidationErrors
+	if errors.As(err, &ves) {
+		return ves
+	}
+	return NewValidationErrorsFromError(err)
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type validatedOptions struct {
+	err error
+}
+
+func (o *validatedOptions) Validate() error {
+	return o.err
+}
+
+func TestValidateRequest(t *testing.T) {
+	invalid := &validatedOptions{err: NewValidationErrorsFromString("Query.limit", "must be 100 or less")}
+
+	t.Run("disabled", func(t *testing.T) {
+		client, err := NewAPIClient("https://example.com")
+		require.NoError(t, err)
+		assert.NoError(t, ValidateRequest(client, "ListUsers", invalid))
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		client, err := NewAPIClient("https://example.com", WithRequestValidation())
+		require.NoError(t, err)
+
+		err = ValidateRequest(client, "ListUsers", invalid)
+		var reqErr *RequestValidationError
+		require.ErrorAs(t, err, &reqErr)
+		assert.Equal(t, "ListUsers", reqErr.OperationID)
+		assert.EqualError(t, err, "invalid ListUsers request: Query.limit must be 100 or less")
+
+		var ves ValidationErrors
+		require.ErrorAs(t, err, &ves)
+		assert.Equal(t, "Query.limit", ves[0].Field)
+
+		assert.NoError(t, ValidateRequest(client, "ListUsers", &validatedOptions{}))
+		assert.NoError(t, ValidateRequest(client, "ListUsers", (*validatedOptions)(nil)))
+		assert.NoError(t, ValidateRequest(client, "ListUsers", nil))
+	})
+
+	t.Run("custom client", func(t *testing.T) {
+		assert.NoError(t, ValidateRequest(customAPIClient{}, "ListUsers", invalid))
+	})
+}
+
+func TestValidateResponse(t *testing.T) {
+	client, err := NewAPIClient("https://example.com", WithResponseValidation())
+	require.NoError(t, err)
+
+	err = ValidateResponse(client, "GetUser", 200, &validatedOptions{err: errors.New("id is required")})
+	var respErr *ResponseValidationError
+	require.ErrorAs(t, err, &respErr)
+	assert.Equal(t, 200, respErr.StatusCode)
+	assert.EqualError(t, err, "invalid GetUser response (status 200): id is required")
+
+	var ves ValidationErrors
+	require.ErrorAs(t, err, &ves)
+	assert.Equal(t, "id is required", ves[0].Message)
+
+	assert.NoError(t, ValidateRequest(client, "GetUser", &validatedOptions{err: errors.New("ignored")}))
+	assert.NoError(t, ValidateResponse(client, "GetUser", 200, []string{"not a validator"}))
+}
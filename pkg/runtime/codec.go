@@ -0,0 +1,188 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"mime"
+	"slices"
+	"strings"
+	"sync"
+
+	"go.yaml.in/yaml/v4"
+)
+
+// ErrNoCodec is returned when no codec is registered for a media type.
+var ErrNoCodec = errors.New("no codec registered for media type")
+
+// Codec marshals and unmarshals bodies of the media types it matches.
+type Codec interface {
+	// Match returns true if the codec handles the media type, given lowercased and without parameters.
+	Match(mediaType string) bool
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// CodecRegistry looks up the Codec of a media type.
+// Codecs registered last take precedence.
+// A nil registry uses DefaultCodecs.
+type CodecRegistry struct {
+	mu     sync.RWMutex
+	codecs []Codec
+}
+
+// DefaultCodecs is the registry used by clients and adapters without one, with XML and YAML codecs.
+var DefaultCodecs = NewCodecRegistry(XMLCodec{}, YAMLCodec{})
+
+// NewCodecRegistry creates a registry with the given codecs.
+func NewCodecRegistry(codecs ...Codec) *CodecRegistry {
+	return &CodecRegistry{codecs: slices.Clone(codecs)}
+}
+
+// Register adds a codec, taking precedence over the ones already registered.
+func (r *CodecRegistry) Register(codec Codec) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.codecs = append(r.codecs, codec)
+}
+
+// Lookup returns the codec for the content type, which may have parameters.
+func (r *CodecRegistry) Lookup(contentType string) (Codec, bool) {
+	if r == nil {
+		r = DefaultCodecs
+	}
+	mediaType := normalizeMediaType(contentType)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, codec := range slices.Backward(r.codecs) {
+		if codec.Match(mediaType) {
+			return codec, true
+		}
+	}
+	return nil, false
+}
+
+// Marshal encodes v with the codec of the content type.
+func (r *CodecRegistry) Marshal(contentType string, v any) ([]byte, error) {
+	codec, ok := r.Lookup(contentType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoCodec, contentType)
+	}
+	return codec.Marshal(v)
+}
+
+// Unmarshal decodes data into v with the codec of the content type.
+func (r *CodecRegistry) Unmarshal(contentType string, data []byte, v any) error {
+	codec, ok := r.Lookup(contentType)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNoCodec, contentType)
+	}
+	return codec.Unmarshal(data, v)
+}
+
+// CodecProvider is implemented by API clients which support codecs.
+type CodecProvider interface {
+	Codecs() *CodecRegistry
+}
+
+// WithCodecs sets the codecs used for request and response bodies of non-JSON media types.
+// Defaults to DefaultCodecs.
+func WithCodecs(codecs *CodecRegistry) APIClientOption {
+	return func(c *Client) error {
+		c.codecs = codecs
+		return nil
+	}
+}
+
+// Codecs returns the codecs set with WithCodecs, or nil to use DefaultCodecs.
+func (c *Client) Codecs() *CodecRegistry {
+	return c.codecs
+}
+
+// ClientCodecs returns the codecs of the API client, or nil to use DefaultCodecs.
+// Used by generated clients to decode responses.
+func ClientCodecs(apiClient APIClient) *CodecRegistry {
+	if p, ok := apiClient.(CodecProvider); ok {
+		return p.Codecs()
+	}
+	return nil
+}
+
+func normalizeMediaType(contentType string) string {
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		return mediaType
+	}
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	return strings.ToLower(strings.TrimSpace(mediaType))
+}
+
+// XMLCodec encodes XML with encoding/xml, using the xml struct tags.
+// It matches application/xml, text/xml and +xml media types.
+type XMLCodec struct{}
+
+// Match returns true for XML media types.
+func (XMLCodec) Match(mediaType string) bool {
+	return mediaType == "application/xml" || mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml")
+}
+
+// Marshal encodes v as XML.
+func (XMLCodec) Marshal(v any) ([]byte, error) {
+	return xml.Marshal(v)
+}
+
+// Unmarshal decodes XML data into v.
+func (XMLCodec) Unmarshal(data []byte, v any) error {
+	return xml.Unmarshal(data, v)
+}
+
+// YAMLCodec encodes YAML through the JSON representation of values,
+// so json struct tags and custom JSON marshalers apply.
+// It matches application/yaml, application/x-yaml, text/yaml, text/x-yaml and +yaml media types.
+type YAMLCodec struct{}
+
+// Match returns true for YAML media types.
+func (YAMLCodec) Match(mediaType string) bool {
+	switch mediaType {
+	case "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+		return true
+	}
+	return strings.HasSuffix(mediaType, "+yaml")
+}
+
+// Marshal encodes v as YAML.
+func (YAMLCodec) Marshal(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	if err = json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(value)
+}
+
+// Unmarshal decodes YAML data into v.
+func (YAMLCodec) Unmarshal(data []byte, v any) error {
+	var value any
+	if err := yaml.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type codecPet struct {
+	XMLName xml.Name `json:"-" xml:"pet"`
+	ID      int      `json:"id" xml:"id,attr"`
+	Name    string   `json:"name" xml:"name"`
+	Tags    []string `json:"tags,omitempty" xml:"tags>tag,omitempty"`
+}
+
+type textCodec struct{}
+
+func (textCodec) Match(mediaType string) bool        { return mediaType == "application/xml" }
+func (textCodec) Marshal(v any) ([]byte, error)      { return []byte("text"), nil }
+func (textCodec) Unmarshal(data []byte, v any) error { return nil }
+
+func TestCodecRegistry_Lookup(t *testing.T) {
+	tests := []struct {
+		contentType string
+		want        Codec
+	}{
+		{"application/xml", XMLCodec{}},
+		{"text/xml; charset=utf-8", XMLCodec{}},
+		{"application/atom+xml", XMLCodec{}},
+		{"Application/YAML", YAMLCodec{}},
+		{"application/x-yaml", YAMLCodec{}},
+		{"application/vnd.api+yaml", YAMLCodec{}},
+		{"application/json", nil},
+		{"application/msgpack", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.contentType, func(t *testing.T) {
+			var registry *CodecRegistry
+			codec, ok := registry.Lookup(tt.contentType)
+			assert.Equal(t, tt.want != nil, ok)
+			assert.Equal(t, tt.want, codec)
+		})
+	}
+
+	t.Run("registered last takes precedence", func(t *testing.T) {
+		registry := NewCodecRegistry(XMLCodec{})
+		registry.Register(textCodec{})
+
+		data, err := registry.Marshal("application/xml", codecPet{})
+		require.NoError(t, err)
+		assert.Equal(t, "text", string(data))
+
+		data, err = registry.Marshal("text/xml", codecPet{Name: "Rex"})
+		require.NoError(t, err)
+		assert.Equal(t, `<pet id="0"><name>Rex</name><tags></tags></pet>`, string(data))
+	})
+
+	t.Run("no codec", func(t *testing.T) {
+		_, err := NewCodecRegistry().Marshal("application/xml", codecPet{})
+		assert.ErrorIs(t, err, ErrNoCodec)
+		assert.ErrorIs(t, NewCodecRegistry().Unmarshal("application/xml", nil, &codecPet{}), ErrNoCodec)
+	})
+}
+
+func TestXMLCodec(t *testing.T) {
+	pet := codecPet{ID: 1, Name: "Rex", Tags: []string{"good", "dog"}}
+	data, err := XMLCodec{}.Marshal(pet)
+	require.NoError(t, err)
+	assert.Equal(t, `<pet id="1"><name>Rex</name><tags><tag>good</tag><tag>dog</tag></tags></pet>`, string(data))
+
+	var decoded codecPet
+	require.NoError(t, XMLCodec{}.Unmarshal(data, &decoded))
+	assert.Equal(t, pet.Name, decoded.Name)
+	assert.Equal(t, pet.Tags, decoded.Tags)
+}
+
+func TestYAMLCodec(t *testing.T) {
+	pet := codecPet{ID: 1, Name: "Rex", Tags: []string{"good"}}
+	data, err := YAMLCodec{}.Marshal(pet)
+	require.NoError(t, err)
+	assert.Equal(t, "id: 1\nname: Rex\ntags:\n    - good\n", string(data))
+
+	var decoded codecPet
+	require.NoError(t, YAMLCodec{}.Unmarshal(data, &decoded))
+	assert.Equal(t, pet, decoded)
+}
+
+func TestClient_CreateRequest_codecs(t *testing.T) {
+	create := func(t *testing.T, client *Client, contentType string) string {
+		t.Helper()
+		req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+			RequestURL:  client.GetBaseURL() + "/pets",
+			Method:      http.MethodPost,
+			ContentType: contentType,
+			Options:     mockRequestOptions{body: codecPet{ID: 1, Name: "Rex"}},
+		})
+		require.NoError(t, err)
+		data, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	client, err := NewAPIClient("https://example.com")
+	require.NoError(t, err)
+	assert.Equal(t, `<pet id="1"><name>Rex</name><tags></tags></pet>`, create(t, client, "application/xml"))
+	assert.Equal(t, "id: 1\nname: Rex\n", create(t, client, "application/yaml"))
+	assert.Equal(t, `{"id":1,"name":"Rex"}`, create(t, client, "application/json"))
+
+	client, err = NewAPIClient("https://example.com", WithCodecs(NewCodecRegistry(textCodec{})))
+	require.NoError(t, err)
+	assert.Equal(t, "text", create(t, client, "application/xml"))
+	assert.Equal(t, client.Codecs(), ClientCodecs(client))
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrUnsupportedContentEncoding is returned by DecompressRequestBody for encodings other than gzip and deflate.
+	ErrUnsupportedContentEncoding = errors.New("unsupported content encoding")
+	// ErrDecompressedBodyTooLarge is returned by DecompressRequestBody when the decompressed body exceeds the limit.
+	ErrDecompressedBodyTooLarge = errors.New("decompressed request body too large")
+)
+
+// requestCompression configures the compression of request bodies.
+// Bodies smaller than minSize bytes are sent uncompressed.
+type requestCompression struct {
+	minSize int
+}
+
+// WithRequestCompression compresses request bodies of at least minSize bytes with gzip
+// and sets the Content-Encoding header. Requests already having a Content-Encoding are sent as is.
+func WithRequestCompression(minSize int) APIClientOption {
+	return func(c *Client) error {
+		if minSize < 0 {
+			return fmt.Errorf("request compression: negative minimum size %d", minSize)
+		}
+		c.compression = &requestCompression{minSize: minSize}
+		return nil
+	}
+}
+
+// compress replaces the request body with its gzip-compressed form, keeping GetBody usable for retries.
+func (rc *requestCompression) compress(req *http.Request) error {
+	if req.GetBody == nil || req.ContentLength < int64(rc.minSize) || req.Header.Get("Content-Encoding") != "" {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = body.Close() }()
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err = io.Copy(zw, body); err != nil {
+		return err
+	}
+	if err = zw.Close(); err != nil {
+		return err
+	}
+
+	compressed := buf.Bytes()
+	req.Body = io.NopCloser(bytes.NewReader(compressed))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(compressed)), nil
+	}
+	req.ContentLength = int64(len(compressed))
+	if req.Header.Get("Content-Length") != "" {
+		req.Header.Set("Content-Length", strconv.Itoa(len(compressed)))
+	}
+	req.Header.Set("Content-Encoding", "gzip")
+	return nil
+}
+
+// decompressResponse replaces a gzip or deflate encoded response body with the decoded one.
+// Responses with other encodings are left unchanged.
+func decompressResponse(resp *http.Response) error {
+	encoding := strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding")))
+	if resp.Body == nil || (encoding != "gzip" && encoding != "deflate") {
+		return nil
+	}
+
+	body, err := newDecompressReader(encoding, resp.Body)
+	if err != nil {
+		return err
+	}
+	resp.Body = body
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// DecompressRequestBody decodes a gzip or deflate encoded request body, as set in Content-Encoding,
+// and replaces it with the decoded bytes. Requests without Content-Encoding are left unchanged.
+// The decoded body is limited to maxSize bytes to guard against decompression bombs.
+// It returns ErrUnsupportedContentEncoding or ErrDecompressedBodyTooLarge, or the decoding error.
+func DecompressRequestBody(r *http.Request, maxSize int64) error {
+	encoding := strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding")))
+	if encoding == "" || encoding == "identity" || r.Body == nil || r.Body == http.NoBody {
+		return nil
+	}
+	if encoding != "gzip" && encoding != "deflate" {
+		return fmt.Errorf("%w: %s", ErrUnsupportedContentEncoding, encoding)
+	}
+
+	zr, err := newDecompressReader(encoding, r.Body)
+	if err != nil {
+		return fmt.Errorf("error decoding %s request body: %w", encoding, err)
+	}
+	defer func() { _ = zr.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(zr, maxSize+1))
+	if err != nil {
+		return fmt.Errorf("error decoding %s request body: %w", encoding, err)
+	}
+	if int64(len(data)) > maxSize {
+		return fmt.Errorf("%w: more than %d bytes", ErrDecompressedBodyTooLarge, maxSize)
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(data))
+	r.ContentLength = int64(len(data))
+	r.Header.Del("Content-Encoding")
+	r.Header.Set("Content-Length", strconv.Itoa(len(data)))
+	return nil
+}
+
+// DecompressErrorStatus returns the status code answering a DecompressRequestBody error:
+// 413 for ErrDecompressedBodyTooLarge, 415 for ErrUnsupportedContentEncoding and fallback otherwise.
+func DecompressErrorStatus(err error, fallback int) int {
+	switch {
+	case errors.Is(err, ErrDecompressedBodyTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, ErrUnsupportedContentEncoding):
+		return http.StatusUnsupportedMediaType
+	default:
+		return fallback
+	}
+}
+
+// newDecompressReader returns a reader decoding gzip or deflate data.
+// Deflate is expected in zlib format (RFC 1950), raw deflate streams are accepted too.
+func newDecompressReader(encoding string, r io.ReadCloser) (io.ReadCloser, error) {
+	if encoding == "gzip" {
+		zr, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return &decompressReader{Reader: zr, zr: zr, body: r}, nil
+	}
+
+	br := bufio.NewReader(r)
+	header, _ := br.Peek(2)
+	if len(header) == 2 && header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+		zr, err := zlib.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return &decompressReader{Reader: zr, zr: zr, body: r}, nil
+	}
+	zr := flate.NewReader(br)
+	return &decompressReader{Reader: zr, zr: zr, body: r}, nil
+}
+
+// decompressReader closes both the decoder and the underlying body.
+type decompressReader struct {
+	io.Reader
+	zr   io.Closer
+	body io.Closer
+}
+
+func (d *decompressReader) Close() error {
+	return errors.Join(d.zr.Close(), d.body.Close())
+}
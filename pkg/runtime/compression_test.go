@@ -0,0 +1,163 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gzipBytes(t *testing.T, data string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func gunzipString(t *testing.T, data []byte) string {
+	t.Helper()
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	out, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	return string(out)
+}
+
+func TestWithRequestCompression(t *testing.T) {
+	longName := strings.Repeat("a", 100)
+
+	newRequest := func(t *testing.T, minSize int, name string) *http.Request {
+		t.Helper()
+		client, err := NewAPIClient("https://example.com", WithRequestCompression(minSize))
+		require.NoError(t, err)
+		req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+			RequestURL:  client.GetBaseURL() + "/items",
+			Method:      http.MethodPost,
+			ContentType: "application/json",
+			Options:     mockRequestOptions{body: map[string]string{"name": name}},
+		})
+		require.NoError(t, err)
+		return req
+	}
+
+	t.Run("compresses bodies above the threshold", func(t *testing.T) {
+		req := newRequest(t, 50, longName)
+		assert.Equal(t, "gzip", req.Header.Get("Content-Encoding"))
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(body)), req.ContentLength)
+		assert.Equal(t, strconv.Itoa(len(body)), req.Header.Get("Content-Length"))
+		assert.Equal(t, `{"name":"`+longName+`"}`, gunzipString(t, body))
+
+		replay, err := req.GetBody()
+		require.NoError(t, err)
+		replayed, err := io.ReadAll(replay)
+		require.NoError(t, err)
+		assert.Equal(t, body, replayed)
+	})
+
+	t.Run("keeps small bodies uncompressed", func(t *testing.T) {
+		req := newRequest(t, 50, "item")
+		assert.Empty(t, req.Header.Get("Content-Encoding"))
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"name":"item"}`, string(body))
+	})
+
+	t.Run("rejects negative threshold", func(t *testing.T) {
+		_, err := NewAPIClient("https://example.com", WithRequestCompression(-1))
+		require.Error(t, err)
+	})
+}
+
+func TestClient_ExecuteRequest_decompressesResponse(t *testing.T) {
+	doer := &sequenceDoer{responses: []*http.Response{{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Encoding": []string{"gzip"}},
+		Body:       io.NopCloser(bytes.NewReader(gzipBytes(t, `{"id":1}`))),
+	}}}
+	client, err := NewAPIClient("https://example.com", WithHTTPClient(doer))
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.com/items", nil)
+	require.NoError(t, err)
+	resp, err := client.ExecuteRequest(context.Background(), req, "/items")
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":1}`, string(resp.Content))
+	assert.Empty(t, resp.Headers.Get("Content-Encoding"))
+}
+
+func TestDecompressRequestBody(t *testing.T) {
+	var zlibBuf, flateBuf bytes.Buffer
+	zw := zlib.NewWriter(&zlibBuf)
+	_, _ = zw.Write([]byte("hello"))
+	require.NoError(t, zw.Close())
+	fw, err := flate.NewWriter(&flateBuf, flate.DefaultCompression)
+	require.NoError(t, err)
+	_, _ = fw.Write([]byte("hello"))
+	require.NoError(t, fw.Close())
+
+	tests := []struct {
+		name     string
+		encoding string
+		body     []byte
+		maxSize  int64
+		expected string
+		err      error
+		status   int
+	}{
+		{name: "gzip", encoding: "gzip", body: gzipBytes(t, "hello"), maxSize: 10, expected: "hello"},
+		{name: "deflate zlib", encoding: "deflate", body: zlibBuf.Bytes(), maxSize: 10, expected: "hello"},
+		{name: "deflate raw", encoding: "deflate", body: flateBuf.Bytes(), maxSize: 10, expected: "hello"},
+		{name: "no encoding", body: []byte("hello"), maxSize: 1, expected: "hello"},
+		{name: "too large", encoding: "gzip", body: gzipBytes(t, strings.Repeat("a", 100)), maxSize: 10, err: ErrDecompressedBodyTooLarge, status: http.StatusRequestEntityTooLarge},
+		{name: "unsupported", encoding: "br", body: []byte("hello"), maxSize: 10, err: ErrUnsupportedContentEncoding, status: http.StatusUnsupportedMediaType},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/items", bytes.NewReader(tc.body))
+			if tc.encoding != "" {
+				r.Header.Set("Content-Encoding", tc.encoding)
+			}
+
+			err := DecompressRequestBody(r, tc.maxSize)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				assert.Equal(t, tc.status, DecompressErrorStatus(err, http.StatusBadRequest))
+				return
+			}
+			require.NoError(t, err)
+
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(body))
+			assert.Empty(t, r.Header.Get("Content-Encoding"))
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+)
+
+// Deprecation describes a call to a deprecated operation, or a call using a deprecated parameter.
+// Parameter and In are empty when the operation itself is deprecated.
+// Sunset is the HTTP-date from the x-sunset extension, if any.
+type Deprecation struct {
+	OperationID string
+	Method      string
+	Path        string
+	Parameter   string
+	In          string
+	Sunset      string
+}
+
+// DeprecationObserver is called by generated clients before sending a request
+// to a deprecated operation or with a deprecated parameter set.
+type DeprecationObserver func(ctx context.Context, d Deprecation)
+
+// DeprecationObserverProvider is implemented by API clients which support a DeprecationObserver.
+type DeprecationObserverProvider interface {
+	DeprecationObserver() DeprecationObserver
+}
+
+// WithDeprecationObserver sets a callback for calls to deprecated operations and parameters.
+func WithDeprecationObserver(fn DeprecationObserver) APIClientOption {
+	return func(c *Client) error {
+		c.deprecationObserver = fn
+		return nil
+	}
+}
+
+// DeprecationObserver returns the observer set with WithDeprecationObserver.
+func (c *Client) DeprecationObserver() DeprecationObserver {
+	return c.deprecationObserver
+}
+
+// ObserveDeprecation passes d to the observer of the API client.
+// It does nothing if the client doesn't implement DeprecationObserverProvider or has no observer set.
+func ObserveDeprecation(ctx context.Context, client APIClient, d Deprecation) {
+	provider, ok := client.(DeprecationObserverProvider)
+	if !ok {
+		return
+	}
+	if observer := provider.DeprecationObserver(); observer != nil {
+		observer(ctx, d)
+	}
+}
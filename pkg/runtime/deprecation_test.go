@@ -0,0 +1,65 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type customAPIClient struct{}
+
+func (customAPIClient) GetBaseURL() string { return "" }
+func (customAPIClient) CreateRequest(context.Context, RequestOptionsParameters, ...RequestEditorFn) (*http.Request, error) {
+	return nil, nil
+}
+func (customAPIClient) ExecuteRequest(context.Context, *http.Request, string) (*Response, error) {
+	return nil, nil
+}
+
+func TestObserveDeprecation(t *testing.T) {
+	d := Deprecation{
+		OperationID: "GetUser",
+		Method:      "GET",
+		Path:        "/users/{id}",
+		Sunset:      "Thu, 31 Dec 2026 00:00:00 GMT",
+	}
+
+	t.Run("observer is called", func(t *testing.T) {
+		var observed []Deprecation
+		client, err := NewAPIClient("https://example.com", WithDeprecationObserver(func(_ context.Context, d Deprecation) {
+			observed = append(observed, d)
+		}))
+		require.NoError(t, err)
+
+		ObserveDeprecation(context.Background(), client, d)
+		assert.Equal(t, []Deprecation{d}, observed)
+	})
+
+	t.Run("no observer", func(t *testing.T) {
+		client, err := NewAPIClient("https://example.com")
+		require.NoError(t, err)
+
+		assert.NotPanics(t, func() {
+			ObserveDeprecation(context.Background(), client, d)
+		})
+	})
+
+	t.Run("custom client without observer support", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			ObserveDeprecation(context.Background(), customAPIClient{}, d)
+		})
+	})
+}
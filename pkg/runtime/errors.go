@@ -11,9 +11,13 @@
 package runtime
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"net/http"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -27,10 +31,16 @@ var (
 
 type ClientAPIErrorOption func(*ClientAPIError)
 
-// ClientAPIError represents type for client API errors.
+// ClientAPIError is returned by generated clients for error responses and unexpected status codes.
+// It wraps the decoded error response, if any, and keeps the details of the response:
+// the operation, the status code, the headers, the raw body and the request ID.
 type ClientAPIError struct {
 	err        error
 	statusCode int
+	operation  *OperationInfo
+	headers    http.Header
+	body       []byte
+	requestID  string
 }
 
 // Error implements the error interface.
@@ -45,6 +55,44 @@ func (e *ClientAPIError) StatusCode() int {
 	return e.statusCode
 }
 
+// Operation returns the operation which failed, or nil if unknown.
+func (e *ClientAPIError) Operation() *OperationInfo {
+	return e.operation
+}
+
+// OperationID returns the ID of the operation which failed, or an empty string if unknown.
+func (e *ClientAPIError) OperationID() string {
+	if e.operation == nil {
+		return ""
+	}
+	return e.operation.ID
+}
+
+// Headers returns the response headers, with the sensitive ones masked.
+func (e *ClientAPIError) Headers() http.Header {
+	return e.headers
+}
+
+// Body returns the raw response body, capped and with the sensitive JSON properties masked.
+func (e *ClientAPIError) Body() []byte {
+	return e.body
+}
+
+// RequestID returns the request ID sent by the server in the response headers, if any.
+func (e *ClientAPIError) RequestID() string {
+	return e.requestID
+}
+
+// IsRetryable returns true if the status code is of a transient failure: 429, 502, 503 or 504.
+func (e *ClientAPIError) IsRetryable() bool {
+	return slices.Contains(defaultRetryableStatusCodes, e.statusCode)
+}
+
+// RetryAfter returns the delay asked by the Retry-After response header, if any.
+func (e *ClientAPIError) RetryAfter() (time.Duration, bool) {
+	return parseRetryAfter(e.headers, time.Now())
+}
+
 // Unwrap returns the underlying error.
 func (e *ClientAPIError) Unwrap() error {
 	return e.err
@@ -65,6 +113,127 @@ func WithStatusCode(code int) ClientAPIErrorOption {
 	}
 }
 
+// WithResponse sets the status code, the operation, the headers, the body and the request ID from the response,
+// according to the ErrorDetails of the API client.
+func WithResponse(apiClient APIClient, resp *Response) ClientAPIErrorOption {
+	return func(e *ClientAPIError) {
+		if resp == nil {
+			return
+		}
+		details := clientErrorDetails(apiClient)
+		e.statusCode = resp.StatusCode
+		e.operation = resp.Operation
+		e.headers = details.sensitive.maskHeader(resp.Headers)
+		e.body = details.body(resp.Content)
+		for _, name := range details.RequestIDHeaders {
+			if value := resp.Headers.Get(name); value != "" {
+				e.requestID = value
+				break
+			}
+		}
+	}
+}
+
+// ErrorDetails configures the response details kept in ClientAPIError.
+//
+// MaxBodySize caps the kept body, in bytes. Defaults to 64 KiB. A negative value drops the body.
+// RequestIDHeaders are the response headers with the request ID, checked in order.
+// Defaults to X-Request-Id, Request-Id, X-Correlation-Id, X-Amzn-RequestId and X-Amz-Request-Id.
+// SensitiveData masks the headers and JSON properties with the given names, using the same masking rules as x-sensitive-data.
+// Authorization, Proxy-Authorization, Cookie and Set-Cookie headers are always fully masked.
+type ErrorDetails struct {
+	MaxBodySize      int
+	RequestIDHeaders []string
+	SensitiveData    map[string]SensitiveDataConfig
+
+	sensitive sensitiveData
+}
+
+// ErrorDetailsProvider is implemented by API clients which configure the details of ClientAPIError.
+type ErrorDetailsProvider interface {
+	ErrorDetails() *ErrorDetails
+}
+
+// WithErrorDetails configures the response details kept in ClientAPIError.
+// SensitiveData adds to the names masked by previous options, such as WithErrorSensitiveData.
+func WithErrorDetails(details ErrorDetails) APIClientOption {
+	return func(c *Client) error {
+		if c.errorDetails != nil {
+			details.SensitiveData = mergeSensitiveData(c.errorDetails.SensitiveData, details.SensitiveData)
+		}
+		details.setDefaults()
+		c.errorDetails = &details
+		return nil
+	}
+}
+
+// WithErrorSensitiveData masks the headers and JSON properties with the given names in ClientAPIError,
+// in addition to the SensitiveData of ErrorDetails.
+// Generated NewDefault clients pass it the properties marked with x-sensitive-data.
+func WithErrorSensitiveData(fields map[string]SensitiveDataConfig) APIClientOption {
+	return func(c *Client) error {
+		details := ErrorDetails{}
+		if c.errorDetails != nil {
+			details = *c.errorDetails
+		}
+		details.SensitiveData = mergeSensitiveData(details.SensitiveData, fields)
+		details.setDefaults()
+		c.errorDetails = &details
+		return nil
+	}
+}
+
+// ErrorDetails returns the details set with WithErrorDetails, or nil to use the defaults.
+func (c *Client) ErrorDetails() *ErrorDetails {
+	return c.errorDetails
+}
+
+var defaultErrorDetails = func() *ErrorDetails {
+	details := &ErrorDetails{}
+	details.setDefaults()
+	return details
+}()
+
+// clientErrorDetails returns the ErrorDetails of the API client, or the defaults.
+func clientErrorDetails(apiClient APIClient) *ErrorDetails {
+	if p, ok := apiClient.(ErrorDetailsProvider); ok {
+		if details := p.ErrorDetails(); details != nil {
+			return details
+		}
+	}
+	return defaultErrorDetails
+}
+
+func (d *ErrorDetails) setDefaults() {
+	if d.MaxBodySize == 0 {
+		d.MaxBodySize = 64 << 10
+	}
+	if len(d.RequestIDHeaders) == 0 {
+		d.RequestIDHeaders = []string{"X-Request-Id", "Request-Id", "X-Correlation-Id", "X-Amzn-RequestId", "X-Amz-Request-Id"}
+	}
+	d.sensitive = newSensitiveData(d.SensitiveData)
+}
+
+// mergeSensitiveData returns the lowercased names of both maps, with the configs of b taking precedence.
+func mergeSensitiveData(a, b map[string]SensitiveDataConfig) map[string]SensitiveDataConfig {
+	res := make(map[string]SensitiveDataConfig, len(a)+len(b))
+	for _, fields := range []map[string]SensitiveDataConfig{a, b} {
+		for name, config := range fields {
+			res[strings.ToLower(name)] = config
+		}
+	}
+	return res
+}
+
+// body returns the masked body, capped at MaxBodySize.
+func (d *ErrorDetails) body(content []byte) []byte {
+	if d.MaxBodySize < 0 || len(content) == 0 {
+		return nil
+	}
+	body := d.sensitive.maskBody(content)
+	return bytes.Clone(body[:min(len(body), d.MaxBodySize)])
+}
+
 type ValidationError struct {
 	Field   string `json:"field"`
 	Message string `json:"message"`
@@ -11,8 +11,13 @@
 package runtime
 
 import (
+	"context"
 	"errors"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/stretchr/testify/assert"
@@ -40,6 +45,113 @@ func TestNewClientAPIError(t *testing.T) {
 	})
 }
 
+func TestClientAPIError_WithResponse(t *testing.T) {
+	resp := &Response{
+		StatusCode: http.StatusBadGateway,
+		Headers: http.Header{
+			"Content-Type": {"application/json"},
+			"X-Request-Id": {"req-1"},
+			"Set-Cookie":   {"session=secret"},
+			"Retry-After":  {"3"},
+		},
+		Content:   []byte(`{"message":"upstream failed","token":"abc123"}`),
+		Operation: &OperationInfo{ID: "GetUser", Method: http.MethodGet, Path: "/users/{id}"},
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		err := NewClientAPIError(errors.New("unexpected status code: 502"), WithResponse(nil, resp))
+
+		var apiErr *ClientAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode())
+		assert.Equal(t, "GetUser", apiErr.OperationID())
+		assert.Equal(t, "/users/{id}", apiErr.Operation().Path)
+		assert.Equal(t, "req-1", apiErr.RequestID())
+		assert.Equal(t, "********", apiErr.Headers().Get("Set-Cookie"))
+		assert.Equal(t, "session=secret", resp.Headers.Get("Set-Cookie"))
+		assert.JSONEq(t, `{"message":"upstream failed","token":"abc123"}`, string(apiErr.Body()))
+		assert.True(t, apiErr.IsRetryable())
+
+		delay, ok := apiErr.RetryAfter()
+		assert.True(t, ok)
+		assert.Equal(t, 3*time.Second, delay)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		client, err := NewAPIClient("https://example.com", WithErrorDetails(ErrorDetails{
+			MaxBodySize:      20,
+			RequestIDHeaders: []string{"X-Trace-Id"},
+			SensitiveData: map[string]SensitiveDataConfig{
+				"token": {Type: MaskTypeFull},
+			},
+		}))
+		require.NoError(t, err)
+
+		err = NewClientAPIError(errors.New("unexpected status code: 502"), WithResponse(client, resp))
+		var apiErr *ClientAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Empty(t, apiErr.RequestID())
+		assert.Equal(t, `{"message":"upstream`, string(apiErr.Body()))
+
+		masked := NewClientAPIError(nil, WithResponse(client, &Response{Content: []byte(`{"token":"abc123"}`)}))
+		require.ErrorAs(t, masked, &apiErr)
+		assert.Equal(t, `{"token":"********"}`, string(apiErr.Body()))
+	})
+
+	t.Run("sensitive data of both options", func(t *testing.T) {
+		client, err := NewAPIClient("https://example.com",
+			WithErrorSensitiveData(map[string]SensitiveDataConfig{
+				"token":   {Type: MaskTypeFull},
+				"message": {Type: MaskTypeFull},
+			}),
+			WithErrorDetails(ErrorDetails{
+				MaxBodySize: 100,
+				SensitiveData: map[string]SensitiveDataConfig{
+					"Message": {Type: MaskTypeRegex, Pattern: "upstream"},
+				},
+			}),
+		)
+		require.NoError(t, err)
+
+		err = NewClientAPIError(nil, WithResponse(client, resp))
+		var apiErr *ClientAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.JSONEq(t, `{"message":"******** failed","token":"********"}`, string(apiErr.Body()))
+	})
+
+	t.Run("not retryable", func(t *testing.T) {
+		err := NewClientAPIError(errors.New("not found"), WithResponse(nil, &Response{StatusCode: http.StatusNotFound}))
+		var apiErr *ClientAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.False(t, apiErr.IsRetryable())
+		assert.Nil(t, apiErr.Body())
+		assert.Nil(t, apiErr.Operation())
+	})
+}
+
+func TestClient_ExecuteRequest_operation(t *testing.T) {
+	client, err := NewAPIClient("https://example.com", WithHTTPClient(&MockHttpRequestDoer{
+		response: &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))},
+	}))
+	require.NoError(t, err)
+
+	req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+		RequestURL: "https://example.com/users/1",
+		Method:     http.MethodGet,
+		Operation:  &OperationInfo{ID: "GetUser", Method: http.MethodGet, Path: "/users/{id}"},
+	})
+	require.NoError(t, err)
+	resp, err := client.ExecuteRequest(context.Background(), req, "/users/{id}")
+	require.NoError(t, err)
+	assert.Equal(t, "GetUser", resp.Operation.ID)
+
+	req, err = http.NewRequest(http.MethodGet, "https://example.com/users/1", nil)
+	require.NoError(t, err)
+	resp, err = client.ExecuteRequest(context.Background(), req, "/users/{id}")
+	require.NoError(t, err)
+	assert.Equal(t, &OperationInfo{Method: http.MethodGet, Path: "/users/{id}"}, resp.Operation)
+}
+
 func TestNewValidationError(t *testing.T) {
 	t.Run("empty field", func(t *testing.T) {
 		err := NewValidationError("", "is required")
@@ -0,0 +1,116 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+)
+
+// ErrFakeNotConfigured is returned by generated fake clients for operations without a programmed response.
+var ErrFakeNotConfigured = errors.New("fake: no response configured for operation")
+
+// TestingT is the subset of testing.TB used by the fake assertions.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// FakeCall is a call recorded by a generated fake client.
+// Options holds the request options of the call, nil for operations without options.
+type FakeCall struct {
+	Operation string
+	Options   any
+}
+
+// FakeCalls records the calls made to a generated fake client. It is safe for concurrent use.
+type FakeCalls struct {
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+// Record records a call to the operation.
+func (f *FakeCalls) Record(operation string, options any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Operation: operation, Options: options})
+}
+
+// Calls returns all recorded calls, in order.
+func (f *FakeCalls) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// CallsTo returns the recorded calls to the operation, in order.
+func (f *FakeCalls) CallsTo(operation string) []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var res []FakeCall
+	for _, call := range f.calls {
+		if call.Operation == operation {
+			res = append(res, call)
+		}
+	}
+	return res
+}
+
+// Reset forgets all recorded calls.
+func (f *FakeCalls) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// AssertCalled reports an error if the operation was never called.
+func (f *FakeCalls) AssertCalled(t TestingT, operation string) bool {
+	t.Helper()
+	if len(f.CallsTo(operation)) == 0 {
+		t.Errorf("expected %s to be called", operation)
+		return false
+	}
+	return true
+}
+
+// AssertNotCalled reports an error if the operation was called.
+func (f *FakeCalls) AssertNotCalled(t TestingT, operation string) bool {
+	t.Helper()
+	if n := len(f.CallsTo(operation)); n > 0 {
+		t.Errorf("expected %s not to be called, called %d times", operation, n)
+		return false
+	}
+	return true
+}
+
+// AssertCalledTimes reports an error if the operation wasn't called exactly n times.
+func (f *FakeCalls) AssertCalledTimes(t TestingT, operation string, n int) bool {
+	t.Helper()
+	if got := len(f.CallsTo(operation)); got != n {
+		t.Errorf("expected %s to be called %d times, called %d times", operation, n, got)
+		return false
+	}
+	return true
+}
+
+// AssertCalledWith reports an error if the operation was never called with options deeply equal to the given ones.
+func (f *FakeCalls) AssertCalledWith(t TestingT, operation string, options any) bool {
+	t.Helper()
+	calls := f.CallsTo(operation)
+	for _, call := range calls {
+		if reflect.DeepEqual(call.Options, options) {
+			return true
+		}
+	}
+	t.Errorf("expected %s to be called with %+v, got %d calls with other options", operation, options, len(calls))
+	return false
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingT struct {
+	errors []string
+}
+
+func (t *recordingT) Helper() {}
+
+func (t *recordingT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestFakeCalls(t *testing.T) {
+	type options struct{ ID string }
+
+	var calls FakeCalls
+	calls.Record("GetUser", &options{ID: "1"})
+	calls.Record("ListUsers", nil)
+	calls.Record("GetUser", &options{ID: "2"})
+
+	assert.Len(t, calls.Calls(), 3)
+	assert.Equal(t, []FakeCall{
+		{Operation: "GetUser", Options: &options{ID: "1"}},
+		{Operation: "GetUser", Options: &options{ID: "2"}},
+	}, calls.CallsTo("GetUser"))
+
+	rt := &recordingT{}
+	assert.True(t, calls.AssertCalled(rt, "GetUser"))
+	assert.True(t, calls.AssertNotCalled(rt, "DeleteUser"))
+	assert.True(t, calls.AssertCalledTimes(rt, "GetUser", 2))
+	assert.True(t, calls.AssertCalledWith(rt, "GetUser", &options{ID: "2"}))
+	assert.Empty(t, rt.errors)
+
+	assert.False(t, calls.AssertCalled(rt, "DeleteUser"))
+	assert.False(t, calls.AssertNotCalled(rt, "ListUsers"))
+	assert.False(t, calls.AssertCalledTimes(rt, "ListUsers", 2))
+	assert.False(t, calls.AssertCalledWith(rt, "GetUser", &options{ID: "3"}))
+	assert.Equal(t, []string{
+		"expected DeleteUser to be called",
+		"expected ListUsers not to be called, called 1 times",
+		"expected ListUsers to be called 2 times, called 1 times",
+		"expected GetUser to be called with &{ID:3}, got 2 calls with other options",
+	}, rt.errors)
+
+	calls.Reset()
+	assert.Empty(t, calls.Calls())
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// HandlerDoer is an HttpRequestDoer serving requests with an http.Handler in the same process.
+// It lets the generated client talk to a router without opening a socket, e.g. in end-to-end tests.
+type HandlerDoer struct {
+	Handler http.Handler
+}
+
+// NewHandlerDoer returns an HttpRequestDoer calling the given handler.
+func NewHandlerDoer(handler http.Handler) *HandlerDoer {
+	return &HandlerDoer{Handler: handler}
+}
+
+// Do serves the request with the handler and returns the recorded response.
+// A panic in the handler is returned as an error.
+func (d *HandlerDoer) Do(ctx context.Context, req *http.Request) (resp *http.Response, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	if req.Body == nil {
+		req.Body = http.NoBody
+	}
+	defer func() { _ = req.Body.Close() }()
+	req.RequestURI = req.URL.RequestURI()
+	if req.RemoteAddr == "" {
+		req.RemoteAddr = "127.0.0.1:0"
+	}
+
+	w := &handlerResponseWriter{header: http.Header{}}
+	defer func() {
+		if r := recover(); r != nil {
+			resp, err = nil, fmt.Errorf("handler panic serving %s %s: %v", req.Method, req.URL.Path, r)
+		}
+	}()
+	d.Handler.ServeHTTP(w, req)
+
+	return w.response(req), nil
+}
+
+// handlerResponseWriter is an in-memory http.ResponseWriter.
+type handlerResponseWriter struct {
+	header      http.Header
+	sentHeader  http.Header
+	statusCode  int
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+func (w *handlerResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *handlerResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.statusCode = statusCode
+	w.sentHeader = w.header.Clone()
+}
+
+func (w *handlerResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		if w.header.Get("Content-Type") == "" && len(b) > 0 {
+			w.header.Set("Content-Type", http.DetectContentType(b))
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.body.Write(b)
+}
+
+// Flush implements http.Flusher, the body is buffered until the handler returns.
+func (w *handlerResponseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (w *handlerResponseWriter) response(req *http.Request) *http.Response {
+	w.Flush()
+	body := w.body.Bytes()
+	if req.Method == http.MethodHead {
+		body = nil
+	}
+	header := w.sentHeader
+	if header.Get("Content-Length") == "" {
+		header.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+	return &http.Response{
+		Status:        strconv.Itoa(w.statusCode) + " " + http.StatusText(w.statusCode),
+		StatusCode:    w.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
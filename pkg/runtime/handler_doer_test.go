@@ -0,0 +1,100 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerDoer(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-URI", r.RequestURI)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": r.PathValue("id"), "body": string(body)})
+		w.Header().Set("X-Too-Late", "1")
+	})
+	mux.HandleFunc("GET /text", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("hello"))
+	})
+	mux.HandleFunc("GET /panic", func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+
+	client, err := NewAPIClient("http://in-process", WithHTTPClient(NewHandlerDoer(mux)))
+	require.NoError(t, err)
+
+	t.Run("serves request", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, "http://in-process/users/42?x=1", strings.NewReader("payload"))
+		require.NoError(t, err)
+
+		resp, err := client.ExecuteRequest(context.Background(), req, "/users/{id}")
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, "201 Created", resp.Raw.Status)
+		assert.Equal(t, "application/json", resp.Headers.Get("Content-Type"))
+		assert.Equal(t, "/users/42?x=1", resp.Headers.Get("X-Request-URI"))
+		assert.Empty(t, resp.Headers.Get("X-Too-Late"))
+		assert.JSONEq(t, `{"id":"42","body":"payload"}`, string(resp.Content))
+	})
+
+	t.Run("implicit status and content type", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "http://in-process/text", nil)
+		require.NoError(t, err)
+
+		resp, err := client.ExecuteRequest(context.Background(), req, "/text")
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/plain; charset=utf-8", resp.Headers.Get("Content-Type"))
+		assert.Equal(t, "5", resp.Headers.Get("Content-Length"))
+		assert.Equal(t, "hello", string(resp.Content))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "http://in-process/missing", nil)
+		require.NoError(t, err)
+
+		resp, err := NewHandlerDoer(mux).Do(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("handler panic", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "http://in-process/panic", nil)
+		require.NoError(t, err)
+
+		_, err = NewHandlerDoer(mux).Do(context.Background(), req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "boom")
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		req, err := http.NewRequest(http.MethodGet, "http://in-process/text", nil)
+		require.NoError(t, err)
+
+		_, err = NewHandlerDoer(mux).Do(ctx, req)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+)
+
+// HTTPClientDoer is an HttpRequestDoer sending requests with an http.Client.
+// It's the default doer of NewAPIClient, with the timeout set by WithTimeout.
+type HTTPClientDoer struct {
+	Client *http.Client
+}
+
+// NewHTTPClientDoer returns an HttpRequestDoer sending requests with the given client,
+// or http.DefaultClient if nil.
+func NewHTTPClientDoer(client *http.Client) *HTTPClientDoer {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPClientDoer{Client: client}
+}
+
+// Do sends the request with the context.
+func (d *HTTPClientDoer) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	return d.Client.Do(req.WithContext(ctx))
+}
@@ -0,0 +1,306 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// IdempotencyKeyHeader is the header carrying the idempotency key of x-idempotent operations.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses replayed from an IdempotencyStore.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+type idempotencyKeyKey struct{}
+
+// WithIdempotencyKey sets the idempotency key of requests made with the context,
+// instead of a generated one. Use it to keep the key stable across separate calls.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyKey{}, key)
+}
+
+func idempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyKey{}).(string)
+	return key, ok && key != ""
+}
+
+// setIdempotencyKey sets the Idempotency-Key header, unless already set.
+// The key is taken from the context or generated.
+func setIdempotencyKey(ctx context.Context, req *http.Request) {
+	if req.Header.Get(IdempotencyKeyHeader) != "" {
+		return
+	}
+	key, ok := idempotencyKeyFromContext(ctx)
+	if !ok {
+		key = uuid.NewString()
+	}
+	req.Header.Set(IdempotencyKeyHeader, key)
+}
+
+// IdempotentResponse is a response stored for an idempotency key.
+type IdempotentResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// IdempotencyEntry is the state of an idempotency key in an IdempotencyStore.
+// Fingerprint identifies the request which reserved the key, Response is nil while it's in progress.
+type IdempotencyEntry struct {
+	Fingerprint string
+	Response    *IdempotentResponse
+}
+
+// IdempotencyStore stores the responses of x-idempotent operations by key,
+// so that duplicate requests are answered with the stored response.
+// Keys are prefixed with the operation ID.
+type IdempotencyStore interface {
+	// Reserve atomically reserves the key for the request with the given fingerprint.
+	// It returns true if the key was free, or the existing entry of an in-progress or completed request.
+	Reserve(ctx context.Context, key, fingerprint string) (*IdempotencyEntry, bool, error)
+	// Complete stores the response for a reserved key.
+	Complete(ctx context.Context, key string, resp *IdempotentResponse) error
+	// Release removes the reservation of a key, so the request can be retried with it.
+	Release(ctx context.Context, key string) error
+}
+
+var (
+	// ErrIdempotencyInProgress is returned by StartIdempotent when a request with the same key is being handled.
+	ErrIdempotencyInProgress = errors.New("a request with the same idempotency key is in progress")
+	// ErrIdempotencyKeyReused is returned by StartIdempotent when the key was used for a different request.
+	ErrIdempotencyKeyReused = errors.New("idempotency key was used for a different request")
+	// ErrIdempotentBodyTooLarge is returned by StartIdempotent when the request body exceeds the limit.
+	ErrIdempotentBodyTooLarge = errors.New("idempotent request body too large")
+)
+
+// IdempotencyErrorStatus returns the status code answering a StartIdempotent error:
+// 409 for ErrIdempotencyInProgress, 422 for ErrIdempotencyKeyReused,
+// 413 for ErrIdempotentBodyTooLarge and fallback otherwise.
+func IdempotencyErrorStatus(err error, fallback int) int {
+	switch {
+	case errors.Is(err, ErrIdempotencyInProgress):
+		return http.StatusConflict
+	case errors.Is(err, ErrIdempotencyKeyReused):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, ErrIdempotentBodyTooLarge):
+		return http.StatusRequestEntityTooLarge
+	default:
+		return fallback
+	}
+}
+
+// MemoryIdempotencyStore is an in-memory IdempotencyStore.
+// Entries expire after the TTL, if set, and are removed by a sweep run at most once per TTL.
+type MemoryIdempotencyStore struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]memoryIdempotencyEntry
+	sweepAt time.Time
+}
+
+type memoryIdempotencyEntry struct {
+	entry     IdempotencyEntry
+	expiresAt time.Time
+}
+
+// NewMemoryIdempotencyStore creates an in-memory IdempotencyStore keeping entries for ttl.
+// A zero ttl keeps them forever.
+func NewMemoryIdempotencyStore(ttl time.Duration) *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{
+		ttl:     ttl,
+		entries: make(map[string]memoryIdempotencyEntry),
+	}
+}
+
+// Reserve reserves the key, unless an entry exists for it and is not expired.
+func (s *MemoryIdempotencyStore) Reserve(_ context.Context, key, fingerprint string) (*IdempotencyEntry, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.sweep(now)
+	if e, ok := s.entries[key]; ok && !e.expired(now) {
+		entry := e.entry
+		return &entry, false, nil
+	}
+	s.entries[key] = s.newEntry(IdempotencyEntry{Fingerprint: fingerprint}, now)
+	return nil, true, nil
+}
+
+// sweep removes the expired entries, once per TTL.
+func (s *MemoryIdempotencyStore) sweep(now time.Time) {
+	if s.ttl <= 0 || now.Before(s.sweepAt) {
+		return
+	}
+	s.sweepAt = now.Add(s.ttl)
+	for k, e := range s.entries {
+		if e.expired(now) {
+			delete(s.entries, k)
+		}
+	}
+}
+
+// Complete stores the response for the key, keeping its fingerprint.
+func (s *MemoryIdempotencyStore) Complete(_ context.Context, key string, resp *IdempotentResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := s.entries[key].entry
+	entry.Response = resp
+	s.entries[key] = s.newEntry(entry, time.Now())
+	return nil
+}
+
+// Release removes the entry of the key.
+func (s *MemoryIdempotencyStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+func (e memoryIdempotencyEntry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && now.After(e.expiresAt)
+}
+
+func (s *MemoryIdempotencyStore) newEntry(entry IdempotencyEntry, now time.Time) memoryIdempotencyEntry {
+	e := memoryIdempotencyEntry{entry: entry}
+	if s.ttl > 0 {
+		e.expiresAt = now.Add(s.ttl)
+	}
+	return e
+}
+
+// IdempotencyRecorder records the response of a request with an idempotency key,
+// and stores it on Finish.
+type IdempotencyRecorder struct {
+	http.ResponseWriter
+	store      IdempotencyStore
+	ctx        context.Context
+	key        string
+	statusCode int
+	body       bytes.Buffer
+}
+
+// StartIdempotent handles the idempotency key of a request to an x-idempotent operation.
+// The key is reserved with a fingerprint of the request method, path, query and body.
+// Bodies larger than maxBodySize bytes return ErrIdempotentBodyTooLarge.
+// If a response is stored for the key, it's written to w and replayed is true.
+// If the key is reserved by a request in progress, ErrIdempotencyInProgress is returned,
+// and ErrIdempotencyKeyReused if it was used for a request with another fingerprint.
+// Otherwise, the returned recorder should be used as the response writer and finished
+// once the request is handled. Requests without the key return a nil recorder.
+func StartIdempotent(w http.ResponseWriter, r *http.Request, store IdempotencyStore, operationID string, maxBodySize int64) (rec *IdempotencyRecorder, replayed bool, err error) {
+	key := r.Header.Get(IdempotencyKeyHeader)
+	if key == "" {
+		return nil, false, nil
+	}
+	key = operationID + ":" + key
+
+	fingerprint, err := idempotencyFingerprint(w, r, maxBodySize)
+	if err != nil {
+		return nil, false, err
+	}
+
+	entry, reserved, err := store.Reserve(r.Context(), key, fingerprint)
+	if err != nil {
+		return nil, false, err
+	}
+	if reserved {
+		return &IdempotencyRecorder{ResponseWriter: w, store: store, ctx: r.Context(), key: key}, false, nil
+	}
+
+	switch {
+	case entry.Fingerprint != fingerprint:
+		return nil, false, ErrIdempotencyKeyReused
+	case entry.Response == nil:
+		return nil, false, ErrIdempotencyInProgress
+	}
+
+	for name, values := range entry.Response.Header {
+		w.Header()[name] = values
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(entry.Response.StatusCode)
+	_, _ = w.Write(entry.Response.Body)
+	return nil, true, nil
+}
+
+// idempotencyFingerprint returns the SHA-256 hash of the request method, path, query and body.
+// The body is read up to maxBodySize bytes and replaced, so it can be read again.
+func idempotencyFingerprint(w http.ResponseWriter, r *http.Request, maxBodySize int64) (string, error) {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.Path + "?" + r.URL.RawQuery + "\n"))
+	if r.Body != nil && r.Body != http.NoBody {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				return "", fmt.Errorf("%w: more than %d bytes", ErrIdempotentBodyTooLarge, maxBodySize)
+			}
+			return "", fmt.Errorf("error reading request body: %w", err)
+		}
+		_ = r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		h.Write(body)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// WriteHeader records the status code and writes it.
+func (rec *IdempotencyRecorder) WriteHeader(statusCode int) {
+	if rec.statusCode == 0 {
+		rec.statusCode = statusCode
+	}
+	rec.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write records the body and writes it.
+func (rec *IdempotencyRecorder) Write(b []byte) (int, error) {
+	if rec.statusCode == 0 {
+		rec.statusCode = http.StatusOK
+	}
+	rec.body.Write(b)
+	return rec.ResponseWriter.Write(b)
+}
+
+// Finish stores the recorded response.
+// Server errors and requests without a response are not stored and release the key,
+// so the request can be retried with it.
+func (rec *IdempotencyRecorder) Finish() error {
+	if rec.statusCode == 0 || rec.statusCode >= http.StatusInternalServerError {
+		return rec.Release()
+	}
+	return rec.store.Complete(context.WithoutCancel(rec.ctx), rec.key, &IdempotentResponse{
+		StatusCode: rec.statusCode,
+		Header:     rec.Header().Clone(),
+		Body:       bytes.Clone(rec.body.Bytes()),
+	})
+}
+
+// Release releases the key without storing the response, e.g. when the handler panics.
+func (rec *IdempotencyRecorder) Release() error {
+	return rec.store.Release(context.WithoutCancel(rec.ctx), rec.key)
+}
@@ -0,0 +1,298 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateRequest_idempotent(t *testing.T) {
+	client, err := NewAPIClient("https://example.com")
+	require.NoError(t, err)
+
+	create := func(ctx context.Context, params RequestOptionsParameters) *http.Request {
+		t.Helper()
+		params.RequestURL = client.GetBaseURL() + "/payments"
+		params.Method = http.MethodPost
+		params.Options = mockRequestOptions{body: map[string]string{"amount": "10"}}
+		req, err := client.CreateRequest(ctx, params)
+		require.NoError(t, err)
+		return req
+	}
+
+	t.Run("generates a key per call and allows retries", func(t *testing.T) {
+		first := create(context.Background(), RequestOptionsParameters{Idempotent: true})
+		second := create(context.Background(), RequestOptionsParameters{Idempotent: true})
+
+		key := first.Header.Get(IdempotencyKeyHeader)
+		assert.NotEmpty(t, key)
+		assert.NotEqual(t, key, second.Header.Get(IdempotencyKeyHeader))
+
+		retryable, ok := retryableFromContext(first.Context())
+		assert.True(t, ok)
+		assert.True(t, retryable)
+	})
+
+	t.Run("uses the key from the context", func(t *testing.T) {
+		req := create(WithIdempotencyKey(context.Background(), "key-1"), RequestOptionsParameters{Idempotent: true})
+		assert.Equal(t, "key-1", req.Header.Get(IdempotencyKeyHeader))
+	})
+
+	t.Run("keeps x-retryable false", func(t *testing.T) {
+		req := create(context.Background(), RequestOptionsParameters{Idempotent: true, Retryable: Ptr(false)})
+		retryable, ok := retryableFromContext(req.Context())
+		assert.True(t, ok)
+		assert.False(t, retryable)
+	})
+
+	t.Run("not idempotent", func(t *testing.T) {
+		req := create(context.Background(), RequestOptionsParameters{})
+		assert.Empty(t, req.Header.Get(IdempotencyKeyHeader))
+	})
+
+	t.Run("keeps the key across retries", func(t *testing.T) {
+		var keys []string
+		doer := &sequenceDoer{responses: []*http.Response{
+			statusResponse(http.StatusServiceUnavailable),
+			statusResponse(http.StatusCreated),
+		}}
+		retryClient := newRetryClient(t, doer, RetryPolicy{
+			InitialBackoff: time.Millisecond,
+			OnAttempt: func(_ context.Context, a RetryAttempt) {
+				keys = append(keys, a.Request.Header.Get(IdempotencyKeyHeader))
+			},
+		})
+		req, err := retryClient.CreateRequest(context.Background(), RequestOptionsParameters{
+			RequestURL: retryClient.GetBaseURL() + "/payments",
+			Method:     http.MethodPost,
+			Options:    mockRequestOptions{body: map[string]string{"amount": "10"}},
+			Idempotent: true,
+		})
+		require.NoError(t, err)
+
+		resp, err := retryClient.ExecuteRequest(context.Background(), req, "/payments")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.Len(t, keys, 2)
+		assert.NotEmpty(t, keys[0])
+		assert.Equal(t, keys[0], keys[1])
+	})
+}
+
+func TestStartIdempotent(t *testing.T) {
+	store := NewMemoryIdempotencyStore(time.Minute)
+	calls := 0
+	handler := func(w http.ResponseWriter, r *http.Request) error {
+		rec, replayed, err := StartIdempotent(w, r, store, "CreatePayment", 1<<20)
+		if err != nil {
+			return err
+		}
+		if replayed {
+			return nil
+		}
+		if rec != nil {
+			defer func() { _ = rec.Finish() }()
+			w = rec
+		}
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(body)
+		return nil
+	}
+
+	send := func(key, body string) (*httptest.ResponseRecorder, error) {
+		r := httptest.NewRequest(http.MethodPost, "/payments", strings.NewReader(body))
+		if key != "" {
+			r.Header.Set(IdempotencyKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		return w, handler(w, r)
+	}
+
+	first, err := send("key-1", `{"id":1}`)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, `{"id":1}`, first.Body.String())
+	assert.Empty(t, first.Header().Get(IdempotentReplayedHeader))
+
+	replay, err := send("key-1", `{"id":1}`)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, replay.Code)
+	assert.Equal(t, `{"id":1}`, replay.Body.String())
+	assert.Equal(t, "application/json", replay.Header().Get("Content-Type"))
+	assert.Equal(t, "true", replay.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(t, 1, calls)
+
+	_, err = send("key-1", `{"id":2}`)
+	require.ErrorIs(t, err, ErrIdempotencyKeyReused)
+	assert.Equal(t, http.StatusUnprocessableEntity, IdempotencyErrorStatus(err, http.StatusInternalServerError))
+	assert.Equal(t, 1, calls)
+
+	_, err = send("key-2", `{"id":1}`)
+	require.NoError(t, err)
+	_, err = send("", `{"id":1}`)
+	require.NoError(t, err)
+	_, err = send("", `{"id":1}`)
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls)
+}
+
+func TestStartIdempotent_inProgress(t *testing.T) {
+	store := NewMemoryIdempotencyStore(0)
+	newRequest := func() *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/payments", strings.NewReader(`{"id":1}`))
+		r.Header.Set(IdempotencyKeyHeader, "key-1")
+		return r
+	}
+
+	rec, replayed, err := StartIdempotent(httptest.NewRecorder(), newRequest(), store, "CreatePayment", 1<<20)
+	require.NoError(t, err)
+	require.False(t, replayed)
+	require.NotNil(t, rec)
+
+	_, _, err = StartIdempotent(httptest.NewRecorder(), newRequest(), store, "CreatePayment", 1<<20)
+	require.ErrorIs(t, err, ErrIdempotencyInProgress)
+	assert.Equal(t, http.StatusConflict, IdempotencyErrorStatus(err, http.StatusInternalServerError))
+
+	rec.WriteHeader(http.StatusCreated)
+	require.NoError(t, rec.Finish())
+
+	w := httptest.NewRecorder()
+	_, replayed, err = StartIdempotent(w, newRequest(), store, "CreatePayment", 1<<20)
+	require.NoError(t, err)
+	assert.True(t, replayed)
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestIdempotencyRecorder_skipsServerErrors(t *testing.T) {
+	store := NewMemoryIdempotencyStore(0)
+	r := httptest.NewRequest(http.MethodPost, "/payments", nil)
+	r.Header.Set(IdempotencyKeyHeader, "key-1")
+
+	rec, replayed, err := StartIdempotent(httptest.NewRecorder(), r, store, "CreatePayment", 1<<20)
+	require.NoError(t, err)
+	require.False(t, replayed)
+	rec.WriteHeader(http.StatusServiceUnavailable)
+	require.NoError(t, rec.Finish())
+
+	_, reserved, err := store.Reserve(context.Background(), "CreatePayment:key-1", "fp")
+	require.NoError(t, err)
+	assert.True(t, reserved)
+}
+
+func TestIdempotencyRecorder_releasesWithoutResponse(t *testing.T) {
+	store := NewMemoryIdempotencyStore(0)
+	newRequest := func() *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/payments", nil)
+		r.Header.Set(IdempotencyKeyHeader, "key-1")
+		return r
+	}
+	handle := func(w http.ResponseWriter, r *http.Request, fn func(http.ResponseWriter)) {
+		rec, replayed, err := StartIdempotent(w, r, store, "CreatePayment", 1<<20)
+		require.NoError(t, err)
+		require.False(t, replayed)
+		defer func() {
+			if p := recover(); p != nil {
+				_ = rec.Release()
+				panic(p)
+			}
+			_ = rec.Finish()
+		}()
+		fn(rec)
+	}
+
+	t.Run("panic", func(t *testing.T) {
+		assert.PanicsWithValue(t, "boom", func() {
+			handle(httptest.NewRecorder(), newRequest(), func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusOK)
+				panic("boom")
+			})
+		})
+
+		w := httptest.NewRecorder()
+		handle(w, newRequest(), func(w http.ResponseWriter) { w.WriteHeader(http.StatusCreated) })
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Empty(t, w.Header().Get(IdempotentReplayedHeader))
+	})
+
+	t.Run("nothing written", func(t *testing.T) {
+		store := NewMemoryIdempotencyStore(0)
+		r := newRequest()
+		rec, _, err := StartIdempotent(httptest.NewRecorder(), r, store, "CreatePayment", 1<<20)
+		require.NoError(t, err)
+		require.NoError(t, rec.Finish())
+
+		_, reserved, err := store.Reserve(context.Background(), "CreatePayment:key-1", "fp")
+		require.NoError(t, err)
+		assert.True(t, reserved)
+	})
+}
+
+func TestStartIdempotent_fingerprint(t *testing.T) {
+	store := NewMemoryIdempotencyStore(0)
+	send := func(target, body string) (*httptest.ResponseRecorder, error) {
+		r := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+		r.Header.Set(IdempotencyKeyHeader, "key-1")
+		w := httptest.NewRecorder()
+		rec, _, err := StartIdempotent(w, r, store, "CreatePayment", 16)
+		if rec != nil {
+			rec.WriteHeader(http.StatusCreated)
+			require.NoError(t, rec.Finish())
+		}
+		return w, err
+	}
+
+	_, err := send("/payments?currency=usd", `{"id":1}`)
+	require.NoError(t, err)
+
+	_, err = send("/payments?currency=eur", `{"id":1}`)
+	assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+
+	_, err = send("/payments?currency=usd", strings.Repeat("a", 17))
+	require.ErrorIs(t, err, ErrIdempotentBodyTooLarge)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, IdempotencyErrorStatus(err, http.StatusInternalServerError))
+}
+
+func TestMemoryIdempotencyStore_expires(t *testing.T) {
+	store := NewMemoryIdempotencyStore(50 * time.Millisecond)
+	ctx := context.Background()
+	_, reserved, err := store.Reserve(ctx, "key", "fp")
+	require.NoError(t, err)
+	require.True(t, reserved)
+	require.NoError(t, store.Complete(ctx, "key", &IdempotentResponse{StatusCode: http.StatusOK}))
+
+	entry, reserved, err := store.Reserve(ctx, "key", "fp")
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.Equal(t, "fp", entry.Fingerprint)
+	assert.Equal(t, http.StatusOK, entry.Response.StatusCode)
+
+	_, reserved, err = store.Reserve(ctx, "other", "fp")
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	time.Sleep(60 * time.Millisecond)
+	_, reserved, err = store.Reserve(ctx, "key", "fp")
+	require.NoError(t, err)
+	assert.True(t, reserved)
+	assert.Len(t, store.entries, 1, "the expired entry of other is swept")
+}
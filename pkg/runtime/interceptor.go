@@ -0,0 +1,72 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// OperationInfo describes the operation a request is made for.
+// ID is the operation ID, Path is the path template, e.g. /users/{id}.
+// Timeout is the deadline of each call to the operation, from x-timeout.
+// Zero uses the timeout of the client, set with WithTimeout.
+type OperationInfo struct {
+	ID      string
+	Method  string
+	Path    string
+	Tags    []string
+	Timeout time.Duration
+}
+
+// ExecuteRequestFn sends a request and returns its response.
+type ExecuteRequestFn func(ctx context.Context, req *http.Request) (*Response, error)
+
+// Interceptor wraps the execution of a request. It must call next to send the request,
+// unless it returns a response by itself, e.g. from a cache.
+type Interceptor func(ctx context.Context, op *OperationInfo, req *http.Request, next ExecuteRequestFn) (*Response, error)
+
+// WithInterceptors adds interceptors to the client.
+// The first interceptor is the outermost one, it runs first and sees the final response.
+// Interceptors run once per call, retries happen inside the chain.
+func WithInterceptors(interceptors ...Interceptor) APIClientOption {
+	return func(c *Client) error {
+		c.interceptors = append(c.interceptors, interceptors...)
+		return nil
+	}
+}
+
+type operationInfoKey struct{}
+
+// WithOperationInfo returns a context carrying the operation of a request.
+func WithOperationInfo(ctx context.Context, op *OperationInfo) context.Context {
+	return context.WithValue(ctx, operationInfoKey{}, op)
+}
+
+// OperationInfoFromContext returns the operation set by the generated client, or nil.
+// It's available to request editors and HttpRequestDoer implementations through req.Context().
+func OperationInfoFromContext(ctx context.Context) *OperationInfo {
+	op, _ := ctx.Value(operationInfoKey{}).(*OperationInfo)
+	return op
+}
+
+// chain wraps the final executor with the interceptors.
+func chain(interceptors []Interceptor, op *OperationInfo, final ExecuteRequestFn) ExecuteRequestFn {
+	next := final
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := interceptors[i], next
+		next = func(ctx context.Context, req *http.Request) (*Response, error) {
+			return interceptor(ctx, op, req, inner)
+		}
+	}
+	return next
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterceptors(t *testing.T) {
+	getUser := &OperationInfo{ID: "GetUser", Method: http.MethodGet, Path: "/users/{id}", Tags: []string{"users"}}
+
+	newRequest := func(t *testing.T, client *Client, op *OperationInfo) *http.Request {
+		t.Helper()
+		req, err := client.CreateRequest(context.Background(), RequestOptionsParameters{
+			RequestURL: client.GetBaseURL() + "/users/{id}",
+			Method:     http.MethodGet,
+			Options:    mockRequestOptions{pathParams: map[string]any{"id": "42"}},
+			Operation:  op,
+		})
+		require.NoError(t, err)
+		return req
+	}
+
+	t.Run("runs in order with operation info", func(t *testing.T) {
+		var calls []string
+		record := func(name string) Interceptor {
+			return func(ctx context.Context, op *OperationInfo, req *http.Request, next ExecuteRequestFn) (*Response, error) {
+				calls = append(calls, name+" "+op.ID+" "+op.Path+" "+req.URL.Path)
+				resp, err := next(ctx, req)
+				calls = append(calls, name+" done")
+				return resp, err
+			}
+		}
+		doer := &sequenceDoer{responses: []*http.Response{statusResponse(http.StatusOK)}}
+		client, err := NewAPIClient("https://example.com", WithHTTPClient(doer), WithInterceptors(record("first"), record("second")))
+		require.NoError(t, err)
+
+		resp, err := client.ExecuteRequest(context.Background(), newRequest(t, client, getUser), "/users/{id}")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []string{
+			"first GetUser /users/{id} /users/42",
+			"second GetUser /users/{id} /users/42",
+			"second done",
+			"first done",
+		}, calls)
+	})
+
+	t.Run("can skip the request", func(t *testing.T) {
+		doer := &sequenceDoer{}
+		cached := func(context.Context, *OperationInfo, *http.Request, ExecuteRequestFn) (*Response, error) {
+			return &Response{StatusCode: http.StatusOK, Content: []byte("cached")}, nil
+		}
+		client, err := NewAPIClient("https://example.com", WithHTTPClient(doer), WithInterceptors(cached))
+		require.NoError(t, err)
+
+		resp, err := client.ExecuteRequest(context.Background(), newRequest(t, client, getUser), "/users/{id}")
+		require.NoError(t, err)
+		assert.Equal(t, "cached", string(resp.Content))
+		assert.Empty(t, doer.bodies)
+	})
+
+	t.Run("wraps retries", func(t *testing.T) {
+		doer := &sequenceDoer{responses: []*http.Response{
+			statusResponse(http.StatusServiceUnavailable),
+			statusResponse(http.StatusOK),
+		}}
+		calls := 0
+		count := func(ctx context.Context, _ *OperationInfo, req *http.Request, next ExecuteRequestFn) (*Response, error) {
+			calls++
+			return next(ctx, req)
+		}
+		client, err := NewAPIClient("https://example.com",
+			WithHTTPClient(doer),
+			WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond}),
+			WithInterceptors(count))
+		require.NoError(t, err)
+
+		resp, err := client.ExecuteRequest(context.Background(), newRequest(t, client, getUser), "/users/{id}")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 1, calls)
+		assert.Len(t, doer.bodies, 2)
+	})
+
+	t.Run("falls back to the operation path", func(t *testing.T) {
+		var got *OperationInfo
+		capture := func(ctx context.Context, op *OperationInfo, req *http.Request, next ExecuteRequestFn) (*Response, error) {
+			got = op
+			return next(ctx, req)
+		}
+		doer := &sequenceDoer{responses: []*http.Response{statusResponse(http.StatusOK)}}
+		client, err := NewAPIClient("https://example.com", WithHTTPClient(doer), WithInterceptors(capture))
+		require.NoError(t, err)
+
+		_, err = client.ExecuteRequest(context.Background(), newRequest(t, client, nil), "/users/{id}")
+		require.NoError(t, err)
+		assert.Equal(t, &OperationInfo{Method: http.MethodGet, Path: "/users/{id}"}, got)
+	})
+
+	t.Run("is available to request editors", func(t *testing.T) {
+		client, err := NewAPIClient("https://example.com")
+		require.NoError(t, err)
+
+		var got *OperationInfo
+		_, err = client.CreateRequest(context.Background(), RequestOptionsParameters{
+			RequestURL: client.GetBaseURL() + "/users",
+			Method:     http.MethodGet,
+			Operation:  getUser,
+		}, func(_ context.Context, req *http.Request) error {
+			got = OperationInfoFromContext(req.Context())
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Same(t, getUser, got)
+	})
+}
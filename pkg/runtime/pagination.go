@@ -0,0 +1,115 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// ErrPaginationLoop is returned by the generated <Op>All methods when a next cursor or link repeats.
+var ErrPaginationLoop = errors.New("pagination loop: next page was already fetched")
+
+// CapturedResponse is the request and response of the last call of a generated client method,
+// recorded in the context set with WithCapturedResponse.
+type CapturedResponse struct {
+	Request  *http.Request
+	Response *Response
+}
+
+type capturedResponseKey struct{}
+
+// WithCapturedResponse returns a context recording the request and response of the
+// generated client methods called with it in dst. The generated <Op>All methods use it
+// to read the Link header of each page.
+func WithCapturedResponse(ctx context.Context, dst *CapturedResponse) context.Context {
+	return context.WithValue(ctx, capturedResponseKey{}, dst)
+}
+
+// CaptureResponse records the request and response in the CapturedResponse of ctx, if set.
+// It's called by the generated client methods.
+func CaptureResponse(ctx context.Context, req *http.Request, resp *Response) {
+	if dst, ok := ctx.Value(capturedResponseKey{}).(*CapturedResponse); ok {
+		dst.Request = req
+		dst.Response = resp
+	}
+}
+
+// NextLink returns the URL of the next page from the RFC 8288 Link headers, or an empty string.
+// Relative URLs are resolved against base.
+func NextLink(header http.Header, base *url.URL) string {
+	for _, value := range header.Values("Link") {
+		for _, link := range splitLinks(value) {
+			target, params, ok := strings.Cut(link, ">")
+			if !ok || !strings.HasPrefix(target, "<") {
+				continue
+			}
+			if !hasRelNext(params) {
+				continue
+			}
+
+			next, err := url.Parse(strings.TrimSpace(target[1:]))
+			if err != nil {
+				continue
+			}
+			if base != nil {
+				next = base.ResolveReference(next)
+			}
+			return next.String()
+		}
+	}
+	return ""
+}
+
+// splitLinks splits a Link header value into links, keeping commas inside the URLs.
+func splitLinks(value string) []string {
+	var links []string
+	for part := range strings.SplitSeq(value, ",") {
+		part = strings.TrimSpace(part)
+		if len(links) > 0 && !strings.HasPrefix(part, "<") {
+			links[len(links)-1] += "," + part
+			continue
+		}
+		links = append(links, part)
+	}
+	return links
+}
+
+func hasRelNext(params string) bool {
+	for param := range strings.SplitSeq(params, ";") {
+		name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if !ok || !strings.EqualFold(strings.TrimSpace(name), "rel") {
+			continue
+		}
+		rels := strings.Fields(strings.ToLower(strings.Trim(strings.TrimSpace(value), `"`)))
+		if slices.Contains(rels, "next") {
+			return true
+		}
+	}
+	return false
+}
+
+// WithRequestURL returns a request editor replacing the URL of the request, e.g. with the next page link.
+func WithRequestURL(rawURL string) RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return err
+		}
+		req.URL = u
+		req.Host = u.Host
+		return nil
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextLink(t *testing.T) {
+	base, _ := url.Parse("https://api.example.com/logs?page=1")
+
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{
+			name:   "absolute",
+			values: []string{`<https://api.example.com/logs?page=2>; rel="next", <https://api.example.com/logs?page=9>; rel="last"`},
+			want:   "https://api.example.com/logs?page=2",
+		},
+		{
+			name:   "relative",
+			values: []string{`</logs?page=2>; rel=next`},
+			want:   "https://api.example.com/logs?page=2",
+		},
+		{
+			name:   "multiple rel values and headers",
+			values: []string{`</logs?page=1>; rel="prev"`, `</logs?ids=1,2&page=2>; title="x"; rel="last next"`},
+			want:   "https://api.example.com/logs?ids=1,2&page=2",
+		},
+		{
+			name:   "no next",
+			values: []string{`</logs?page=1>; rel="prev"`},
+		},
+		{
+			name: "no header",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for _, v := range tt.values {
+				header.Add("Link", v)
+			}
+			assert.Equal(t, tt.want, NextLink(header, base))
+		})
+	}
+}
+
+func TestWithRequestURL(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://api.example.com/logs", nil)
+	require.NoError(t, err)
+
+	require.NoError(t, WithRequestURL("https://other.example.com/logs?page=2")(context.Background(), req))
+	assert.Equal(t, "https://other.example.com/logs?page=2", req.URL.String())
+	assert.Equal(t, "other.example.com", req.Host)
+}
@@ -11,6 +11,8 @@
 package runtime
 
 import (
+	"encoding"
+	"reflect"
 	"strconv"
 	"time"
 
@@ -22,6 +24,8 @@ import (
 // uint, uint8, uint16, uint32, uint64, float32, float64, bool, string,
 // as well as special types like uuid.UUID and time.Time when the appropriate
 // format hint is provided.
+// Other types are parsed with encoding.TextUnmarshaler if implemented,
+// or by their underlying primitive kind (e.g. type Code string).
 //
 // The optional format parameter is the OpenAPI format (e.g., "uuid", "date-time", "date").
 func ParseString[T any](s string, format ...string) (T, error) {
@@ -118,7 +122,53 @@ func ParseString[T any](s string, format ...string) (T, error) {
 		*p = s
 		return result, nil
 	}
-	return result, nil
+
+	// Types with a format hint above are only parsed when the hint is provided.
+	switch any(result).(type) {
+	case uuid.UUID, time.Time, Date:
+		return result, nil
+	}
+
+	// Custom types, e.g. configured via type-mappings
+	if u, ok := any(&result).(encoding.TextUnmarshaler); ok {
+		return result, u.UnmarshalText([]byte(s))
+	}
+
+	return result, parseKind(reflect.ValueOf(&result).Elem(), s)
+}
+
+// parseKind sets v from s based on the underlying kind of v.
+// Unsupported kinds are left untouched.
+func parseKind(v reflect.Value, s string) error {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.String:
+		v.SetString(s)
+	}
+	return nil
 }
 
 // ParseStringSlice parses a slice of strings into a slice of the target type T.
@@ -11,6 +11,8 @@
 package runtime
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -162,6 +164,49 @@ func TestParseString(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, uuid.UUID{}, v) // Zero value since no format hint
 	})
+
+	t.Run("text unmarshaler", func(t *testing.T) {
+		v, err := ParseString[testTextValue]("abc")
+		require.NoError(t, err)
+		assert.Equal(t, testTextValue{value: "ABC"}, v)
+	})
+
+	t.Run("text unmarshaler invalid", func(t *testing.T) {
+		_, err := ParseString[testTextValue]("")
+		assert.Error(t, err)
+	})
+
+	t.Run("named int", func(t *testing.T) {
+		type ID int64
+		v, err := ParseString[ID]("42")
+		require.NoError(t, err)
+		assert.Equal(t, ID(42), v)
+	})
+
+	t.Run("named int invalid", func(t *testing.T) {
+		type ID int64
+		_, err := ParseString[ID]("abc")
+		assert.Error(t, err)
+	})
+
+	t.Run("named string", func(t *testing.T) {
+		type Code string
+		v, err := ParseString[Code]("abc")
+		require.NoError(t, err)
+		assert.Equal(t, Code("abc"), v)
+	})
+}
+
+type testTextValue struct {
+	value string
+}
+
+func (v *testTextValue) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return errors.New("empty value")
+	}
+	v.value = strings.ToUpper(string(text))
+	return nil
 }
 
 func TestParseStringSlice(t *testing.T) {
@@ -0,0 +1,159 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ErrNoOperationLocation is returned when the response starting a long-running operation
+// has neither an Operation-Location nor a Location header.
+var ErrNoOperationLocation = errors.New("response has no Operation-Location or Location header")
+
+// OperationFailedError is returned when a long-running operation ends in a failure state.
+type OperationFailedError struct {
+	OperationID string
+	State       string
+}
+
+func (e *OperationFailedError) Error() string {
+	return fmt.Sprintf("operation %s failed with state %q", e.OperationID, e.State)
+}
+
+// PollOptions configures the polling of long-running operations by the generated <Op>AndWait methods.
+//
+// InitialInterval is the delay before the first poll. Defaults to 1s.
+// MaxInterval caps the delay between polls. Defaults to 30s.
+// Multiplier is the interval growth factor. Defaults to 1.5.
+// A Retry-After header on the last response sets the next delay instead.
+// OnPoll is called after each poll, e.g. for logging.
+type PollOptions struct {
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+	OnPoll          func(ctx context.Context, poll PollAttempt)
+}
+
+// PollAttempt describes a finished poll.
+// Poll is 1-based. Response and Err are the result of the poll.
+// Done is true if the operation completed, otherwise another poll follows after Delay.
+type PollAttempt struct {
+	Poll     int
+	Response *Response
+	Err      error
+	Done     bool
+	Delay    time.Duration
+}
+
+func (o *PollOptions) withDefaults() PollOptions {
+	var opts PollOptions
+	if o != nil {
+		opts = *o
+	}
+	if opts.InitialInterval <= 0 {
+		opts.InitialInterval = time.Second
+	}
+	if opts.MaxInterval <= 0 {
+		opts.MaxInterval = 30 * time.Second
+	}
+	if opts.Multiplier < 1 {
+		opts.Multiplier = 1.5
+	}
+	return opts
+}
+
+// OperationLocation returns the URL to poll for the status of a long-running operation,
+// from the Operation-Location or the Location header, resolved against the request URL.
+// It returns an empty string if there is none.
+func OperationLocation(header http.Header, requestURL *url.URL) string {
+	location := header.Get("Operation-Location")
+	if location == "" {
+		location = header.Get("Location")
+	}
+	if location == "" || requestURL == nil {
+		return location
+	}
+	ref, err := url.Parse(location)
+	if err != nil {
+		return location
+	}
+	return requestURL.ResolveReference(ref).String()
+}
+
+// PollUntilDone polls the status of a long-running operation until poll reports it done.
+// resp is the response which started the operation.
+// Polls are delayed with exponential backoff, or by the Retry-After header of the last response.
+// Transient errors from poll, a retryable *ClientAPIError or the timeout of the poll itself,
+// are followed by another poll after the backoff delay. Other errors stop the polling
+// and are returned along with the result.
+func PollUntilDone[T any](ctx context.Context, opts *PollOptions, resp *Response, poll func(ctx context.Context) (*Response, T, bool, error)) (T, error) {
+	options := opts.withDefaults()
+	delay := options.delay(1, resp)
+
+	for attempt := 1; ; attempt++ {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			var zero T
+			return zero, ctx.Err()
+		case <-timer.C:
+		}
+
+		resp, res, done, err := poll(ctx)
+		done = done || (err != nil && !isTransientPollError(ctx, err))
+		delay = 0
+		if !done {
+			delay = options.delay(attempt+1, resp)
+		}
+
+		if options.OnPoll != nil {
+			options.OnPoll(ctx, PollAttempt{
+				Poll:     attempt,
+				Response: resp,
+				Err:      err,
+				Done:     done,
+				Delay:    delay,
+			})
+		}
+
+		if done {
+			return res, err
+		}
+	}
+}
+
+// isTransientPollError returns true if the poll can be retried after the error:
+// a retryable *ClientAPIError, or the deadline of the poll while ctx is still active.
+func isTransientPollError(ctx context.Context, err error) bool {
+	var apiErr *ClientAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr.IsRetryable()
+	}
+	return errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil
+}
+
+// delay returns the delay before the given poll, starting from 1, after the response.
+func (o *PollOptions) delay(poll int, resp *Response) time.Duration {
+	if resp != nil {
+		if after, ok := parseRetryAfter(resp.Headers, time.Now()); ok {
+			return after
+		}
+	}
+	delay := float64(o.InitialInterval) * math.Pow(o.Multiplier, float64(poll-1))
+	return time.Duration(min(delay, float64(o.MaxInterval)))
+}
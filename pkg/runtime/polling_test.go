@@ -0,0 +1,160 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationLocation(t *testing.T) {
+	base, err := url.Parse("https://example.com/v1/reports")
+	require.NoError(t, err)
+
+	t.Run("prefers Operation-Location", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("Location", "/v1/reports/1")
+		header.Set("Operation-Location", "/v1/operations/1")
+		assert.Equal(t, "https://example.com/v1/operations/1", OperationLocation(header, base))
+	})
+
+	t.Run("resolves relative Location", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("Location", "reports/1/status")
+		assert.Equal(t, "https://example.com/v1/reports/1/status", OperationLocation(header, base))
+	})
+
+	t.Run("keeps absolute URL", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("Location", "https://status.example.com/1")
+		assert.Equal(t, "https://status.example.com/1", OperationLocation(header, base))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		assert.Empty(t, OperationLocation(http.Header{}, base))
+	})
+}
+
+func TestPollUntilDone(t *testing.T) {
+	opts := &PollOptions{InitialInterval: time.Millisecond, MaxInterval: 4 * time.Millisecond, Multiplier: 2}
+
+	t.Run("polls until done", func(t *testing.T) {
+		var attempts []PollAttempt
+		pollOpts := *opts
+		pollOpts.OnPoll = func(_ context.Context, a PollAttempt) {
+			attempts = append(attempts, a)
+		}
+
+		polls := 0
+		res, err := PollUntilDone(context.Background(), &pollOpts, nil, func(context.Context) (*Response, string, bool, error) {
+			polls++
+			if polls < 4 {
+				return &Response{StatusCode: http.StatusOK}, "", false, nil
+			}
+			return &Response{StatusCode: http.StatusOK}, "done", true, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "done", res)
+		require.Len(t, attempts, 4)
+		assert.Equal(t, []time.Duration{2 * time.Millisecond, 4 * time.Millisecond, 4 * time.Millisecond, 0},
+			[]time.Duration{attempts[0].Delay, attempts[1].Delay, attempts[2].Delay, attempts[3].Delay})
+		assert.True(t, attempts[3].Done)
+	})
+
+	t.Run("honors Retry-After", func(t *testing.T) {
+		var delays []time.Duration
+		pollOpts := *opts
+		pollOpts.OnPoll = func(_ context.Context, a PollAttempt) {
+			delays = append(delays, a.Delay)
+		}
+
+		polls := 0
+		_, err := PollUntilDone(context.Background(), &pollOpts, nil, func(context.Context) (*Response, string, bool, error) {
+			polls++
+			if polls == 1 {
+				return &Response{StatusCode: http.StatusOK, Headers: http.Header{"Retry-After": {"0"}}}, "", false, nil
+			}
+			return &Response{StatusCode: http.StatusOK}, "done", true, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []time.Duration{0, 0}, delays)
+	})
+
+	t.Run("stops on error", func(t *testing.T) {
+		errFailed := errors.New("failed")
+		polls := 0
+		_, err := PollUntilDone(context.Background(), opts, nil, func(context.Context) (*Response, string, bool, error) {
+			polls++
+			return nil, "", false, errFailed
+		})
+		assert.ErrorIs(t, err, errFailed)
+		assert.Equal(t, 1, polls)
+	})
+
+	t.Run("keeps polling on transient errors", func(t *testing.T) {
+		var attempts []PollAttempt
+		pollOpts := *opts
+		pollOpts.OnPoll = func(_ context.Context, a PollAttempt) {
+			attempts = append(attempts, a)
+		}
+
+		polls := 0
+		res, err := PollUntilDone(context.Background(), &pollOpts, nil, func(context.Context) (*Response, string, bool, error) {
+			polls++
+			switch polls {
+			case 1:
+				return nil, "", false, NewClientAPIError(errors.New("unavailable"), WithStatusCode(http.StatusServiceUnavailable))
+			case 2:
+				return nil, "", false, fmt.Errorf("error executing request: %w", context.DeadlineExceeded)
+			}
+			return &Response{StatusCode: http.StatusOK}, "done", true, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "done", res)
+		require.Len(t, attempts, 3)
+		assert.Error(t, attempts[0].Err)
+		assert.False(t, attempts[0].Done)
+		assert.Equal(t, 2*time.Millisecond, attempts[0].Delay)
+		assert.False(t, attempts[1].Done)
+	})
+
+	t.Run("stops on non-retryable API errors", func(t *testing.T) {
+		polls := 0
+		_, err := PollUntilDone(context.Background(), opts, nil, func(context.Context) (*Response, string, bool, error) {
+			polls++
+			return nil, "", false, NewClientAPIError(errors.New("not found"), WithStatusCode(http.StatusNotFound))
+		})
+		var apiErr *ClientAPIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+		assert.Equal(t, 1, polls)
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		polls := 0
+		_, err := PollUntilDone(ctx, opts, nil, func(context.Context) (*Response, string, bool, error) {
+			polls++
+			cancel()
+			return nil, "", false, nil
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, polls)
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+var patternCache sync.Map // map[string]*regexp.Regexp
+
+// MatchPattern reports whether s matches the JSON Schema pattern.
+// Patterns are not anchored, as in JSON Schema. Compiled patterns are cached.
+// Invalid patterns never match.
+func MatchPattern(pattern, s string) bool {
+	if cached, ok := patternCache.Load(pattern); ok {
+		re, _ := cached.(*regexp.Regexp)
+		return re != nil && re.MatchString(s)
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		re = nil
+	}
+	patternCache.Store(pattern, re)
+	return re != nil && re.MatchString(s)
+}
+
+// PropertyNames describes the JSON Schema propertyNames constraints
+// that every key of an object must satisfy.
+type PropertyNames struct {
+	Pattern   string
+	Enum      []string
+	MinLength *int
+	MaxLength *int
+}
+
+// Validate checks the property name against the constraints.
+// It returns a ValidationError with the property name as field.
+func (p PropertyNames) Validate(name string) error {
+	if p.Pattern != "" && !MatchPattern(p.Pattern, name) {
+		return NewValidationError(name, fmt.Sprintf("property name must match pattern %q", p.Pattern))
+	}
+
+	if len(p.Enum) > 0 && !slices.Contains(p.Enum, name) {
+		return NewValidationError(name, fmt.Sprintf("property name must be one of [%s]", strings.Join(p.Enum, " ")))
+	}
+
+	length := utf8.RuneCountInString(name)
+	if p.MinLength != nil && length < *p.MinLength {
+		return NewValidationError(name, fmt.Sprintf("property name must be at least %d characters long", *p.MinLength))
+	}
+	if p.MaxLength != nil && length > *p.MaxLength {
+		return NewValidationError(name, fmt.Sprintf("property name must be at most %d characters long", *p.MaxLength))
+	}
+
+	return nil
+}
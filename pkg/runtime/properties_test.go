@@ -0,0 +1,58 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchPattern(t *testing.T) {
+	assert.True(t, MatchPattern("^x-", "x-foo"))
+	assert.False(t, MatchPattern("^x-", "foo"))
+	// not anchored
+	assert.True(t, MatchPattern("[a-z]{2}", "12ab34"))
+	// invalid patterns never match, also when cached
+	assert.False(t, MatchPattern("(", "("))
+	assert.False(t, MatchPattern("(", "("))
+}
+
+func TestPropertyNames_Validate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		p := PropertyNames{Pattern: "^[a-z]{2}(-[A-Z]{2})?$", MinLength: ptr(2), MaxLength: ptr(5)}
+		assert.NoError(t, p.Validate("en"))
+		assert.NoError(t, p.Validate("en-US"))
+	})
+
+	t.Run("pattern", func(t *testing.T) {
+		err := PropertyNames{Pattern: "^[a-z]+$"}.Validate("EN")
+		require.Error(t, err)
+
+		var ve ValidationError
+		require.ErrorAs(t, err, &ve)
+		assert.Equal(t, "EN", ve.Field)
+		assert.Equal(t, `property name must match pattern "^[a-z]+$"`, ve.Message)
+	})
+
+	t.Run("enum", func(t *testing.T) {
+		p := PropertyNames{Enum: []string{"a", "b"}}
+		assert.NoError(t, p.Validate("a"))
+		assert.EqualError(t, p.Validate("c"), "c property name must be one of [a b]")
+	})
+
+	t.Run("length", func(t *testing.T) {
+		p := PropertyNames{MinLength: ptr(2), MaxLength: ptr(3)}
+		assert.EqualError(t, p.Validate("a"), "a property name must be at least 2 characters long")
+		assert.EqualError(t, p.Validate("abcd"), "abcd property name must be at most 3 characters long")
+	})
+}
@@ -0,0 +1,229 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures retries of failed requests with exponential backoff and jitter.
+// Only idempotent methods are retried, unless the operation is marked with x-retryable
+// or the context is marked with WithRetryable.
+//
+// MaxAttempts is the total number of attempts, including the first one. Defaults to 3.
+// InitialBackoff is the delay before the first retry. Defaults to 100ms.
+// MaxBackoff caps the delay between attempts. Defaults to 10s.
+// A Retry-After header asking for a longer delay stops the retries.
+// Multiplier is the backoff growth factor. Defaults to 2.
+// Jitter randomizes each delay by up to the given fraction, between 0 and 1.
+// RetryableStatusCodes are the status codes to retry. Defaults to 429, 502, 503 and 504.
+// OnAttempt is called after each attempt, e.g. for logging.
+type RetryPolicy struct {
+	MaxAttempts          int
+	InitialBackoff       time.Duration
+	MaxBackoff           time.Duration
+	Multiplier           float64
+	Jitter               float64
+	RetryableStatusCodes []int
+	OnAttempt            func(ctx context.Context, attempt RetryAttempt)
+}
+
+// RetryAttempt describes a finished attempt.
+// Attempt is 1-based. Response and Err are the result of the attempt.
+// Retry is true if another attempt follows after Delay.
+type RetryAttempt struct {
+	Attempt  int
+	Request  *http.Request
+	Response *http.Response
+	Err      error
+	Retry    bool
+	Delay    time.Duration
+}
+
+// WithRetryPolicy enables retries of failed requests.
+func WithRetryPolicy(policy RetryPolicy) APIClientOption {
+	return func(c *Client) error {
+		policy.setDefaults()
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+type retryableKey struct{}
+
+// WithRetryable marks requests made with the context as safe or unsafe to retry,
+// overriding the method and the x-retryable extension of the operation.
+func WithRetryable(ctx context.Context, retryable bool) context.Context {
+	return context.WithValue(ctx, retryableKey{}, retryable)
+}
+
+func retryableFromContext(ctx context.Context) (bool, bool) {
+	retryable, ok := ctx.Value(retryableKey{}).(bool)
+	return retryable, ok
+}
+
+// defaultRetryableStatusCodes are the status codes of transient failures.
+var defaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+var idempotentMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodOptions,
+	http.MethodTrace,
+	http.MethodPut,
+	http.MethodDelete,
+}
+
+func (p *RetryPolicy) setDefaults() {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 3
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = 100 * time.Millisecond
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = 10 * time.Second
+	}
+	if p.Multiplier < 1 {
+		p.Multiplier = 2
+	}
+	p.Jitter = min(max(p.Jitter, 0), 1)
+	if len(p.RetryableStatusCodes) == 0 {
+		p.RetryableStatusCodes = slices.Clone(defaultRetryableStatusCodes)
+	}
+}
+
+// canRetry returns true if the request may be sent more than once.
+func (p *RetryPolicy) canRetry(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	if retryable, ok := retryableFromContext(req.Context()); ok {
+		return retryable
+	}
+	return slices.Contains(idempotentMethods, req.Method)
+}
+
+// shouldRetry returns true if the attempt failed with a retryable error or status code.
+func (p *RetryPolicy) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return resp != nil && slices.Contains(p.RetryableStatusCodes, resp.StatusCode)
+}
+
+// backoff returns the delay before the given retry, starting from 1.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(retry-1))
+	delay = min(delay, float64(p.MaxBackoff))
+	if p.Jitter > 0 {
+		delay += delay * p.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(delay)
+}
+
+// retryAfter parses the Retry-After header, in seconds or as an HTTP-date.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	return parseRetryAfter(resp.Header, now)
+}
+
+// parseRetryAfter parses the Retry-After header of the headers.
+func parseRetryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// do sends the request, retrying it according to the policy.
+func (p *RetryPolicy) do(ctx context.Context, doer HttpRequestDoer, req *http.Request) (*http.Response, error) {
+	canRetry := p.canRetry(req)
+
+	for attempt := 1; ; attempt++ {
+		resp, err := doer.Do(ctx, req)
+
+		retry := canRetry && attempt < p.MaxAttempts && p.shouldRetry(ctx, resp, err)
+		var delay time.Duration
+		if retry {
+			delay = p.backoff(attempt)
+			if after, ok := retryAfter(resp, time.Now()); ok {
+				delay = after
+				retry = after <= p.MaxBackoff
+			}
+		}
+		if !retry {
+			delay = 0
+		}
+
+		if p.OnAttempt != nil {
+			p.OnAttempt(ctx, RetryAttempt{
+				Attempt:  attempt,
+				Request:  req,
+				Response: resp,
+				Err:      err,
+				Retry:    retry,
+				Delay:    delay,
+			})
+		}
+
+		if !retry {
+			return resp, err
+		}
+
+		if resp != nil && resp.Body != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
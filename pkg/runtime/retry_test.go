@@ -0,0 +1,267 @@
+// Copyright 2026 DoorDash, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sequenceDoer returns the responses in order and records the request bodies.
+type sequenceDoer struct {
+	responses []*http.Response
+	errs      []error
+	bodies    []string
+}
+
+func (d *sequenceDoer) Do(_ context.Context, req *http.Request) (*http.Response, error) {
+	i := len(d.bodies)
+	body := ""
+	if req.Body != nil {
+		b, _ := io.ReadAll(req.Body)
+		body = string(b)
+	}
+	d.bodies = append(d.bodies, body)
+
+	var err error
+	if i < len(d.errs) {
+		err = d.errs[i]
+	}
+	if err != nil {
+		return nil, err
+	}
+	return d.responses[i], nil
+}
+
+func statusResponse(code int, headers ...string) *http.Response {
+	h := http.Header{}
+	for i := 0; i+1 < len(headers); i += 2 {
+		h.Set(headers[i], headers[i+1])
+	}
+	return &http.Response{StatusCode: code, Header: h, Body: io.NopCloser(strings.NewReader(""))}
+}
+
+func newRetryClient(t *testing.T, doer HttpRequestDoer, policy RetryPolicy) *Client {
+	t.Helper()
+	client, err := NewAPIClient("https://example.com", WithHTTPClient(doer), WithRetryPolicy(policy))
+	require.NoError(t, err)
+	return client
+}
+
+func createTestRequest(t *testing.T, ctx context.Context, client *Client, method string, retryable *bool) *http.Request {
+	t.Helper()
+	req, err := client.CreateRequest(ctx, RequestOptionsParameters{
+		RequestURL: client.GetBaseURL() + "/items",
+		Method:     method,
+		Options:    mockRequestOptions{body: map[string]string{"name": "item"}},
+		Retryable:  retryable,
+	})
+	require.NoError(t, err)
+	return req
+}
+
+func TestRetryPolicy(t *testing.T) {
+	fastPolicy := RetryPolicy{InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+
+	t.Run("retries idempotent methods and replays the body", func(t *testing.T) {
+		doer := &sequenceDoer{responses: []*http.Response{
+			statusResponse(http.StatusServiceUnavailable),
+			statusResponse(http.StatusBadGateway),
+			statusResponse(http.StatusOK),
+		}}
+		var attempts []RetryAttempt
+		policy := fastPolicy
+		policy.OnAttempt = func(_ context.Context, a RetryAttempt) {
+			attempts = append(attempts, a)
+		}
+		client := newRetryClient(t, doer, policy)
+
+		resp, err := client.ExecuteRequest(context.Background(), createTestRequest(t, context.Background(), client, http.MethodPut, nil), "/items")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []string{`{"name":"item"}`, `{"name":"item"}`, `{"name":"item"}`}, doer.bodies)
+
+		require.Len(t, attempts, 3)
+		assert.Equal(t, 1, attempts[0].Attempt)
+		assert.True(t, attempts[0].Retry)
+		assert.Equal(t, http.StatusServiceUnavailable, attempts[0].Response.StatusCode)
+		assert.False(t, attempts[2].Retry)
+		assert.Zero(t, attempts[2].Delay)
+	})
+
+	t.Run("keeps the request context on retries", func(t *testing.T) {
+		type ctxKey struct{}
+		doer := &sequenceDoer{responses: []*http.Response{
+			statusResponse(http.StatusServiceUnavailable),
+			statusResponse(http.StatusOK),
+		}}
+		var values []any
+		policy := fastPolicy
+		policy.OnAttempt = func(_ context.Context, a RetryAttempt) {
+			values = append(values, a.Request.Context().Value(ctxKey{}))
+		}
+		client := newRetryClient(t, doer, policy)
+
+		reqCtx := context.WithValue(context.Background(), ctxKey{}, "request")
+		_, err := client.ExecuteRequest(context.Background(), createTestRequest(t, reqCtx, client, http.MethodGet, nil), "/items")
+		require.NoError(t, err)
+		assert.Equal(t, []any{"request", "request"}, values)
+	})
+
+	t.Run("stops after max attempts", func(t *testing.T) {
+		doer := &sequenceDoer{responses: []*http.Response{
+			statusResponse(http.StatusServiceUnavailable),
+			statusResponse(http.StatusServiceUnavailable),
+		}}
+		policy := fastPolicy
+		policy.MaxAttempts = 2
+		client := newRetryClient(t, doer, policy)
+
+		resp, err := client.ExecuteRequest(context.Background(), createTestRequest(t, context.Background(), client, http.MethodGet, nil), "/items")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Len(t, doer.bodies, 2)
+	})
+
+	t.Run("does not retry non-idempotent methods", func(t *testing.T) {
+		doer := &sequenceDoer{responses: []*http.Response{statusResponse(http.StatusServiceUnavailable)}}
+		client := newRetryClient(t, doer, fastPolicy)
+
+		resp, err := client.ExecuteRequest(context.Background(), createTestRequest(t, context.Background(), client, http.MethodPost, nil), "/items")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Len(t, doer.bodies, 1)
+	})
+
+	t.Run("retries operations marked as retryable", func(t *testing.T) {
+		doer := &sequenceDoer{responses: []*http.Response{
+			statusResponse(http.StatusTooManyRequests),
+			statusResponse(http.StatusCreated),
+		}}
+		client := newRetryClient(t, doer, fastPolicy)
+
+		resp, err := client.ExecuteRequest(context.Background(), createTestRequest(t, context.Background(), client, http.MethodPost, Ptr(true)), "/items")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Len(t, doer.bodies, 2)
+	})
+
+	t.Run("context overrides the operation", func(t *testing.T) {
+		doer := &sequenceDoer{responses: []*http.Response{statusResponse(http.StatusServiceUnavailable)}}
+		client := newRetryClient(t, doer, fastPolicy)
+
+		ctx := WithRetryable(context.Background(), false)
+		resp, err := client.ExecuteRequest(ctx, createTestRequest(t, ctx, client, http.MethodGet, Ptr(true)), "/items")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Len(t, doer.bodies, 1)
+	})
+
+	t.Run("retries transport errors", func(t *testing.T) {
+		doer := &sequenceDoer{
+			errs:      []error{errors.New("connection reset")},
+			responses: []*http.Response{nil, statusResponse(http.StatusOK)},
+		}
+		client := newRetryClient(t, doer, fastPolicy)
+
+		resp, err := client.ExecuteRequest(context.Background(), createTestRequest(t, context.Background(), client, http.MethodGet, nil), "/items")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("honors Retry-After", func(t *testing.T) {
+		doer := &sequenceDoer{responses: []*http.Response{
+			statusResponse(http.StatusTooManyRequests, "Retry-After", "0"),
+			statusResponse(http.StatusOK),
+		}}
+		var delays []time.Duration
+		policy := RetryPolicy{InitialBackoff: time.Hour, OnAttempt: func(_ context.Context, a RetryAttempt) {
+			delays = append(delays, a.Delay)
+		}}
+		client := newRetryClient(t, doer, policy)
+
+		resp, err := client.ExecuteRequest(context.Background(), createTestRequest(t, context.Background(), client, http.MethodGet, nil), "/items")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []time.Duration{0, 0}, delays)
+	})
+
+	t.Run("stops if Retry-After exceeds max backoff", func(t *testing.T) {
+		doer := &sequenceDoer{responses: []*http.Response{
+			statusResponse(http.StatusServiceUnavailable, "Retry-After", "3600"),
+		}}
+		client := newRetryClient(t, doer, fastPolicy)
+
+		resp, err := client.ExecuteRequest(context.Background(), createTestRequest(t, context.Background(), client, http.MethodGet, nil), "/items")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Len(t, doer.bodies, 1)
+	})
+
+	t.Run("stops when context is canceled", func(t *testing.T) {
+		doer := &sequenceDoer{responses: []*http.Response{
+			statusResponse(http.StatusServiceUnavailable),
+			statusResponse(http.StatusOK),
+		}}
+		ctx, cancel := context.WithCancel(context.Background())
+		policy := RetryPolicy{InitialBackoff: time.Hour, MaxBackoff: time.Hour, OnAttempt: func(context.Context, RetryAttempt) {
+			cancel()
+		}}
+		client := newRetryClient(t, doer, policy)
+
+		_, err := client.ExecuteRequest(ctx, createTestRequest(t, ctx, client, http.MethodGet, nil), "/items")
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Len(t, doer.bodies, 1)
+	})
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	policy.setDefaults()
+
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.backoff(2))
+	assert.Equal(t, 400*time.Millisecond, policy.backoff(3))
+	assert.Equal(t, time.Second, policy.backoff(10))
+
+	policy.Jitter = 0.5
+	for range 100 {
+		delay := policy.backoff(1)
+		assert.GreaterOrEqual(t, delay, 50*time.Millisecond)
+		assert.LessOrEqual(t, delay, 150*time.Millisecond)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	delay, ok := retryAfter(statusResponse(http.StatusTooManyRequests, "Retry-After", "5"), now)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, delay)
+
+	delay, ok = retryAfter(statusResponse(http.StatusTooManyRequests, "Retry-After", "Thu, 01 Jan 2026 00:00:30 GMT"), now)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, delay)
+
+	_, ok = retryAfter(statusResponse(http.StatusTooManyRequests, "Retry-After", "soon"), now)
+	assert.False(t, ok)
+
+	_, ok = retryAfter(statusResponse(http.StatusTooManyRequests), now)
+	assert.False(t, ok)
+}
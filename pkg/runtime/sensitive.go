@@ -15,6 +15,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"regexp"
 	"strings"
 
@@ -241,3 +242,61 @@ func maskPartial(value, replacement string, keepPrefix, keepSuffix int) string {
 
 	return prefix + replacement + suffix
 }
+
+// defaultSensitiveHeaders are always masked in recorded and captured responses.
+var defaultSensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// sensitiveData maps the lowercased names of sensitive headers, query parameters and JSON properties
+// to their masking rules.
+type sensitiveData map[string]SensitiveDataConfig
+
+// newSensitiveData returns the masking rules for the given names, with defaultSensitiveHeaders fully masked.
+func newSensitiveData(names map[string]SensitiveDataConfig) sensitiveData {
+	res := sensitiveData{}
+	for _, name := range defaultSensitiveHeaders {
+		res[strings.ToLower(name)] = *NewDefaultSensitiveDataConfig()
+	}
+	for name, config := range names {
+		res[strings.ToLower(name)] = config
+	}
+	return res
+}
+
+func (s sensitiveData) maskHeader(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	res := header.Clone()
+	for name, values := range res {
+		if config, ok := s[strings.ToLower(name)]; ok {
+			for i, value := range values {
+				values[i] = MaskSensitiveString(value, config)
+			}
+		}
+	}
+	return res
+}
+
+// maskBody masks the sensitive properties of a JSON body and returns it in canonical form.
+// Other bodies are returned unchanged.
+func (s sensitiveData) maskBody(body []byte) []byte {
+	return canonicalJSON(body, s.maskValue)
+}
+
+func (s sensitiveData) maskValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if config, ok := s[strings.ToLower(key)]; ok && item != nil {
+				v[key] = MaskSensitiveValue(item, config)
+				continue
+			}
+			v[key] = s.maskValue(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = s.maskValue(item)
+		}
+	}
+	return value
+}